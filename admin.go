@@ -0,0 +1,33 @@
+package weather
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// AdminAPI is an implementation of the AdminService server.
+// It allows the operation of every station to be changed, so it must only be served to operators
+// and never alongside the WeatherService.
+type AdminAPI struct {
+	UnsafeAdminServiceServer
+
+	logger  *zap.Logger
+	offline *OfflineSwitch
+}
+
+// NewAdminAPI creates a new admin service server which controls the supplied offline switch.
+func NewAdminAPI(logger *zap.Logger, offline *OfflineSwitch) *AdminAPI {
+	return &AdminAPI{
+		logger:  logger,
+		offline: offline,
+	}
+}
+
+// SetOfflineMode switches between offline and online mode.
+func (api *AdminAPI) SetOfflineMode(ctx context.Context, req *SetOfflineModeRequest) (*SetOfflineModeResponse, error) {
+	api.offline.Set(req.Offline)
+	return &SetOfflineModeResponse{
+		Offline: api.offline.Offline(),
+	}, nil
+}
@@ -2,24 +2,55 @@ package weather
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	defaultWatchInterval = time.Minute
+	defaultMaxAge        = time.Hour * 3
+	// defaultFallbackStations is the number of stations tried by default before a request fails.
+	defaultFallbackStations = 3
+	// defaultInterpolationStations is the number of stations blended by default into an interpolated report.
+	defaultInterpolationStations = 4
+	// maxInterpolationStations limits the number of stations a request may ask to be blended.
+	maxInterpolationStations = 16
 )
 
 var (
 	// ErrLocationNotFound is returned if the supplied lat/lon value can't be found.
 	ErrLocationNotFound = status.New(codes.NotFound, "location not found")
+	// ErrStationTooFar is returned if the closest station is further from the supplied lat/lon than the max distance.
+	ErrStationTooFar = status.New(codes.NotFound, "no station within the max distance")
+	// ErrDataExpired is returned if the data of the closest station is older than the configured max age.
+	ErrDataExpired = status.New(codes.Unavailable, "station data has expired")
+	// ErrNoData is returned by a station which hasn't yet been successfully refreshed.
+	ErrNoData = errors.New("no data available")
 )
 
 // Station represents a single weather station location.
+// Refresh retrieves the latest data from the upstream provider; the Get methods only return the data
+// retrieved by the most recent successful refresh. Implementations must be safe for concurrent use,
+// which embedding a StationCache provides.
 type Station interface {
 	Name() string
 	Latitude() float64
 	Longitude() float64
+	Refresh(ctx context.Context) error
+	LastRefreshed() time.Time
+	NextRefresh() time.Time
+	Stale() bool
 	GetReport(ctx context.Context) (*WeatherReport, error)
 	GetForecast(ctx context.Context) ([]*WeatherForecast, error)
+	GetAlerts(ctx context.Context) ([]*WeatherAlert, error)
 }
 
 // API is an implementation of the WeatherService server.
@@ -27,60 +58,587 @@ type API struct {
 	UnsafeWeatherServiceServer
 
 	logger   *zap.Logger
-	stations *GeoSet
+	stations *GeoSet[Station]
+	// ctx is cancelled when the server is shutting down, ending any streams in progress.
+	ctx context.Context
+
+	watchInterval time.Duration
+	maxAge        time.Duration
+	maxDistanceKm float64
+	// fallbackStations is the number of the closest stations tried in turn until one can answer a request.
+	fallbackStations int
+	// interpolationStations is the number of the closest stations blended into an interpolated report.
+	interpolationStations int
+	offline               *OfflineSwitch
+	history               *HistoryStore
+	scheduler             *Scheduler
 }
 
 // NewAPI creates a new weather service server.
 func NewAPI(logger *zap.Logger) *API {
 	return &API{
-		logger:   logger,
-		stations: NewGeoSet(),
+		logger:                logger,
+		stations:              NewGeoSet[Station](),
+		ctx:                   context.Background(),
+		watchInterval:         defaultWatchInterval,
+		maxAge:                defaultMaxAge,
+		fallbackStations:      defaultFallbackStations,
+		interpolationStations: defaultInterpolationStations,
+		offline:               NewOfflineSwitch(logger, false),
+	}
+}
+
+// SetContext sets the context of the server, which ends any streams in progress when it is cancelled
+// so that the server can shut down gracefully. It must be called before serving.
+func (api *API) SetContext(ctx context.Context) {
+	api.ctx = ctx
+}
+
+// SetMaxAge sets how old the data of a station may be before it is no longer returned.
+// Until then, data which couldn't be refreshed is returned marked as stale. Zero means there is no limit.
+func (api *API) SetMaxAge(maxAge time.Duration) {
+	api.maxAge = maxAge
+}
+
+// SetMaxDistance sets how far away, in kilometres, the closest station may be from the requested location
+// when the request doesn't specify a max distance itself. Zero means there is no limit.
+func (api *API) SetMaxDistance(km float64) {
+	api.maxDistanceKm = km
+}
+
+// SetFallbackStations sets how many of the closest stations are tried in turn, until one has data which can be
+// returned, before a request for a report, forecast or alerts fails. One disables falling back to further stations.
+func (api *API) SetFallbackStations(n int) {
+	if n < 1 {
+		n = 1
+	}
+	api.fallbackStations = n
+}
+
+// SetInterpolationStations sets how many of the closest stations are blended into an interpolated report
+// when the request doesn't specify the number itself.
+func (api *API) SetInterpolationStations(n int) {
+	if n < 1 {
+		n = 1
 	}
+	api.interpolationStations = n
 }
 
-// RegisterStation takes the supplied station and adds it to the queryable set.
+// RegisterStation takes the supplied station and adds it to the queryable set, and to the scheduler if one is set.
+// It may be called while serving; a station with the same name and location as one already registered replaces it,
+// and the replaced station is no longer refreshed.
 func (api *API) RegisterStation(s Station) {
-	api.stations.Add(s.Latitude(), s.Longitude(), s)
+	api.stations.Add(stationKey(s), s.Latitude(), s.Longitude(), s)
+	if api.scheduler != nil {
+		api.scheduler.Add(s)
+	}
 }
 
-// GetCurrentReport gets a weather report
-func (api *API) GetCurrentReport(ctx context.Context, req *GetCurrentReportRequest) (*GetCurrentReportResponse, error) {
-	s := api.stations.Closest(req.Latitude, req.Longitude).(Station)
-	if s == nil {
+// UnregisterStation removes the supplied station from the queryable set, and stops the scheduler refreshing it.
+// It may be called while serving.
+func (api *API) UnregisterStation(s Station) {
+	api.stations.Remove(stationKey(s))
+	if api.scheduler != nil {
+		api.scheduler.Remove(s)
+	}
+}
+
+// SetScheduler sets the scheduler which refreshes the registered stations.
+// It must be called before any stations are registered.
+func (api *API) SetScheduler(scheduler *Scheduler) {
+	api.scheduler = scheduler
+}
+
+// stationKey returns a key identifying the station, which is used both to find it again to be replaced or removed
+// and to name its snapshot and history files, so it is safe to use in file paths.
+// The location is included as names aren't necessarily unique.
+func stationKey(station Station) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		default:
+			return '-'
+		}
+	}, station.Name())
+
+	return fmt.Sprintf("%s_%.4f_%.4f", name, station.Latitude(), station.Longitude())
+}
+
+// SetOfflineSwitch sets the switch which controls offline mode.
+// While offline, data is returned regardless of its age and responses are flagged as offline.
+func (api *API) SetOfflineSwitch(offline *OfflineSwitch) {
+	api.offline = offline
+}
+
+// SetHistoryStore sets the store which past reports are returned from by GetHistory.
+func (api *API) SetHistoryStore(history *HistoryStore) {
+	api.history = history
+}
+
+// checkAge returns whether the data of the supplied station is stale, and its age.
+// An error is returned if the data is older than the max age, unless in offline mode.
+func (api *API) checkAge(s Station) (bool, *durationpb.Duration, error) {
+	age := time.Since(s.LastRefreshed())
+	if api.maxAge > 0 && age > api.maxAge && !api.offline.Offline() {
+		api.logger.Info("station data has expired",
+			zap.String("name", s.Name()),
+			zap.Duration("age", age),
+		)
+		return false, nil, ErrDataExpired.Err()
+	}
+
+	return s.Stale(), durationpb.New(age), nil
+}
+
+// closestStation returns the station closest to the supplied location, as long as it is within maxDistanceKm,
+// or the default max distance if that is zero.
+func (api *API) closestStation(lat float64, lon float64, maxDistanceKm float64) (GeoResult[Station], error) {
+	results, err := api.nearbyStations(lat, lon, maxDistanceKm, 1)
+	if err != nil {
+		return GeoResult[Station]{}, err
+	}
+	return results[0], nil
+}
+
+// nearbyStations returns up to n of the stations closest to the supplied location, closest first, which are
+// within maxDistanceKm, or the default max distance if that is zero.
+func (api *API) nearbyStations(lat float64, lon float64, maxDistanceKm float64, n int) ([]GeoResult[Station], error) {
+	if maxDistanceKm <= 0 {
+		maxDistanceKm = api.maxDistanceKm
+	}
+
+	var results []GeoResult[Station]
+	var tooFar *GeoResult[Station]
+	api.stations.Nearest(lat, lon)(func(result GeoResult[Station]) bool {
+		if maxDistanceKm > 0 && result.Distance > maxDistanceKm*1000 {
+			tooFar = &result
+			return false
+		}
+
+		results = append(results, result)
+		return len(results) < n
+	})
+
+	if len(results) > 0 {
+		return results, nil
+	} else if tooFar == nil {
 		return nil, ErrLocationNotFound.Err()
 	}
 
+	api.logger.Debug("closest station is too far away",
+		zap.String("name", tooFar.Value.Name()),
+		zap.Float64("distance_km", tooFar.Distance/1000),
+		zap.Float64("max_distance_km", maxDistanceKm),
+	)
+	return nil, ErrStationTooFar.Err()
+}
+
+// firstAvailable calls answer with each of the stations closest to the supplied location in turn, closest first,
+// until it succeeds for one of them, which is returned along with the closer stations which were skipped.
+// Up to the configured number of fallback stations are tried; if none of them succeed the error returned for
+// the closest is returned.
+func (api *API) firstAvailable(lat float64, lon float64, maxDistanceKm float64, answer func(s Station) error) (GeoResult[Station], []*SkippedStation, error) {
+	candidates, err := api.nearbyStations(lat, lon, maxDistanceKm, api.fallbackStations)
+	if err != nil {
+		return GeoResult[Station]{}, nil, err
+	}
+
+	var skipped []*SkippedStation
+	var closestErr error
+	for _, candidate := range candidates {
+		err = answer(candidate.Value)
+		if err == nil {
+			return candidate, skipped, nil
+		}
+
+		if closestErr == nil {
+			closestErr = err
+		}
+		skipped = append(skipped, api.skipStation(candidate, err))
+	}
+
+	return GeoResult[Station]{}, skipped, closestErr
+}
+
+// skipStation records that the supplied station couldn't be used to answer a request because of err.
+func (api *API) skipStation(candidate GeoResult[Station], err error) *SkippedStation {
+	api.logger.Info("skipping station",
+		zap.String("name", candidate.Value.Name()),
+		zap.Float64("distance_km", candidate.Distance/1000),
+		zap.Error(err),
+	)
+
+	return &SkippedStation{
+		StationName:       candidate.Value.Name(),
+		StationLocation:   stationLocation(candidate),
+		StationDistanceKm: candidate.Distance / 1000,
+		Reason:            status.Convert(err).Message(),
+	}
+}
+
+// stationLocation returns the location of the station found by a lookup.
+func stationLocation(result GeoResult[Station]) *GeoPoint {
+	return &GeoPoint{
+		Latitude:  result.Latitude,
+		Longitude: result.Longitude,
+	}
+}
+
+// GetCurrentReport gets a weather report.
+// If the closest station can't return a report, the next closest stations are tried in turn.
+func (api *API) GetCurrentReport(ctx context.Context, req *GetCurrentReportRequest) (*GetCurrentReportResponse, error) {
+	if req.Mode == ReportMode_INTERPOLATED {
+		return api.getInterpolatedReport(ctx, req)
+	}
+
+	var report *WeatherReport
+	var stale bool
+	var age *durationpb.Duration
+	answered, skipped, err := api.firstAvailable(req.Latitude, req.Longitude, req.MaxDistanceKm, func(s Station) error {
+		var err error
+		report, stale, age, err = api.stationReport(ctx, s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetCurrentReportResponse{
+		Report:            report,
+		StationName:       answered.Value.Name(),
+		Stale:             stale,
+		Age:               age,
+		Offline:           api.offline.Offline(),
+		StationLocation:   stationLocation(answered),
+		StationDistanceKm: answered.Distance / 1000,
+		SkippedStations:   skipped,
+	}, nil
+}
+
+// stationReport returns the report of the supplied station, along with whether it is stale and its age.
+func (api *API) stationReport(ctx context.Context, s Station) (*WeatherReport, bool, *durationpb.Duration, error) {
 	report, err := s.GetReport(ctx)
 	if err != nil {
-		api.logger.Info("error getting station report",
-			zap.String("name", s.Name()),
-			zap.Error(err),
-		)
+		return nil, false, nil, status.Error(codes.Unavailable, err.Error())
+	} else if report == nil {
+		return nil, false, nil, status.Error(codes.Unavailable, "no report available")
 	}
 
+	stale, age, err := api.checkAge(s)
+	if err != nil {
+		return nil, false, nil, err
+	}
+	return report, stale, age, nil
+}
+
+// getInterpolatedReport blends the reports of the closest stations which can return one.
+// The response is stale if any of the stations are, and its age is that of the oldest.
+func (api *API) getInterpolatedReport(ctx context.Context, req *GetCurrentReportRequest) (*GetCurrentReportResponse, error) {
+	n := api.interpolationStations
+	if req.InterpolationStations > 0 {
+		n = min(int(req.InterpolationStations), maxInterpolationStations)
+	}
+
+	candidates, err := api.nearbyStations(req.Latitude, req.Longitude, req.MaxDistanceKm, n)
+	if err != nil {
+		return nil, err
+	}
+
+	var contributors []GeoResult[Station]
+	var reports []*WeatherReport
+	var skipped []*SkippedStation
+	var closestErr error
+	stale := false
+	var age *durationpb.Duration
+	for _, candidate := range candidates {
+		report, candidateStale, candidateAge, err := api.stationReport(ctx, candidate.Value)
+		if err == nil && report.GetConditions() == nil {
+			err = status.Error(codes.Unavailable, "no conditions reported")
+		}
+		if err != nil {
+			if closestErr == nil {
+				closestErr = err
+			}
+			skipped = append(skipped, api.skipStation(candidate, err))
+			continue
+		}
+
+		contributors = append(contributors, candidate)
+		reports = append(reports, report)
+		stale = stale || candidateStale
+		if age == nil || candidateAge.AsDuration() > age.AsDuration() {
+			age = candidateAge
+		}
+	}
+	if len(contributors) < 1 {
+		return nil, closestErr
+	}
+
+	distances := make([]float64, len(contributors))
+	conditions := make([]*WeatherCondition, len(contributors))
+	for i, contributor := range contributors {
+		distances[i] = contributor.Distance
+		conditions[i] = reports[i].Conditions
+	}
+	weights := idwWeights(distances)
+
+	contributing := make([]*ContributingStation, len(contributors))
+	for i, contributor := range contributors {
+		contributing[i] = &ContributingStation{
+			StationName:       contributor.Value.Name(),
+			StationLocation:   stationLocation(contributor),
+			StationDistanceKm: contributor.Distance / 1000,
+			Weight:            weights[i],
+		}
+	}
+
+	// The observation details are those of the closest station, along with the values which can't be blended.
+	report := proto.Clone(reports[0]).(*WeatherReport)
+	report.Conditions = interpolateConditions(conditions, weights)
+
+	closest := contributors[0]
 	return &GetCurrentReportResponse{
-		Report:      report,
-		StationName: s.Name(),
+		Report:               report,
+		StationName:          closest.Value.Name(),
+		Stale:                stale,
+		Age:                  age,
+		Offline:              api.offline.Offline(),
+		StationLocation:      stationLocation(closest),
+		StationDistanceKm:    closest.Distance / 1000,
+		SkippedStations:      skipped,
+		ContributingStations: contributing,
 	}, nil
 }
 
 // GetForecast gets a weather forecast.
+// If the closest station can't return a forecast, the next closest stations are tried in turn.
 func (api *API) GetForecast(ctx context.Context, req *GetForecastRequest) (*GetForecastResponse, error) {
-	s := api.stations.Closest(req.Latitude, req.Longitude).(Station)
-	if s == nil {
-		return nil, ErrLocationNotFound.Err()
+	var forecast []*WeatherForecast
+	var stale bool
+	var age *durationpb.Duration
+	answered, skipped, err := api.firstAvailable(req.Latitude, req.Longitude, req.MaxDistanceKm, func(s Station) error {
+		var err error
+		forecast, err = s.GetForecast(ctx)
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+
+		stale, age, err = api.checkAge(s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetForecastResponse{
+		ForecastRecords:   forecast,
+		StationName:       answered.Value.Name(),
+		Stale:             stale,
+		Age:               age,
+		Offline:           api.offline.Offline(),
+		StationLocation:   stationLocation(answered),
+		StationDistanceKm: answered.Distance / 1000,
+		SkippedStations:   skipped,
+	}, nil
+}
+
+// GetAlerts gets the weather alerts currently in effect.
+// If the closest station can't return its alerts, the next closest stations are tried in turn.
+func (api *API) GetAlerts(ctx context.Context, req *GetAlertsRequest) (*GetAlertsResponse, error) {
+	var alerts []*WeatherAlert
+	var stale bool
+	var age *durationpb.Duration
+	answered, skipped, err := api.firstAvailable(req.Latitude, req.Longitude, req.MaxDistanceKm, func(s Station) error {
+		var err error
+		alerts, err = s.GetAlerts(ctx)
+		if err != nil {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+
+		stale, age, err = api.checkAge(s)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Stations may cover a larger area than the alerts they report on, so only return the alerts that apply to the
+	// requested location.
+	var applicableAlerts []*WeatherAlert
+	for _, alert := range alerts {
+		if alertApplies(alert, req.Latitude, req.Longitude) {
+			applicableAlerts = append(applicableAlerts, alert)
+		}
+	}
+
+	return &GetAlertsResponse{
+		Alerts:            applicableAlerts,
+		StationName:       answered.Value.Name(),
+		Stale:             stale,
+		Age:               age,
+		Offline:           api.offline.Offline(),
+		StationLocation:   stationLocation(answered),
+		StationDistanceKm: answered.Distance / 1000,
+		SkippedStations:   skipped,
+	}, nil
+}
+
+// WatchReport streams a weather report to the client each time the closest station produces a new observation.
+// The stream remains open until the client cancels it, or the server shuts down.
+func (api *API) WatchReport(req *WatchReportRequest, stream WeatherService_WatchReportServer) error {
+	closest, err := api.closestStation(req.Latitude, req.Longitude, req.MaxDistanceKm)
+	if err != nil {
+		return err
+	}
+	s := closest.Value
+
+	ctx := stream.Context()
+	ticker := time.NewTicker(api.watchInterval)
+	defer ticker.Stop()
+
+	lastObservationID := ""
+	for {
+		report, err := s.GetReport(ctx)
+		if err != nil {
+			api.logger.Info("error getting station report",
+				zap.String("name", s.Name()),
+				zap.Error(err),
+			)
+		} else if report != nil && report.ObservationId != lastObservationID {
+			sent, err := api.sendWatchReport(stream, closest, report)
+			if err != nil {
+				api.logger.Info("error sending station report",
+					zap.String("name", s.Name()),
+					zap.Error(err),
+				)
+				return err
+			}
+
+			// An expired report is sent once the station has been refreshed, even if the observation is unchanged.
+			if sent {
+				lastObservationID = report.ObservationId
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-api.ctx.Done():
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendWatchReport sends the supplied report on the stream, unless the station data has expired.
+// It returns whether the report was sent.
+func (api *API) sendWatchReport(stream WeatherService_WatchReportServer, closest GeoResult[Station], report *WeatherReport) (bool, error) {
+	s := closest.Value
+	stale, age, err := api.checkAge(s)
+	if err != nil {
+		return false, nil
+	}
+
+	err = stream.Send(&WatchReportResponse{
+		Report:            report,
+		StationName:       s.Name(),
+		Stale:             stale,
+		Age:               age,
+		Offline:           api.offline.Offline(),
+		StationLocation:   stationLocation(closest),
+		StationDistanceKm: closest.Distance / 1000,
+	})
+	return err == nil, err
+}
+
+// GetHistory gets the reports recorded by a station over a period of time.
+func (api *API) GetHistory(ctx context.Context, req *GetHistoryRequest) (*GetHistoryResponse, error) {
+	if api.history == nil {
+		return nil, status.Error(codes.Unimplemented, "history is not being recorded")
+	}
+
+	closest, err := api.closestStation(req.Latitude, req.Longitude, req.MaxDistanceKm)
+	if err != nil {
+		return nil, err
+	}
+	s := closest.Value
+
+	end := time.Now()
+	if req.End != nil {
+		end = req.End.AsTime()
+	}
+	if req.Start == nil || !req.Start.AsTime().Before(end) {
+		return nil, status.Error(codes.InvalidArgument, "start must be set and before end")
 	}
 
-	forecast, err := s.GetForecast(ctx)
+	reports, err := api.history.Query(s, req.Start.AsTime(), end)
 	if err != nil {
-		api.logger.Info("error getting station forecast",
+		api.logger.Info("error getting station history",
 			zap.String("name", s.Name()),
 			zap.Error(err),
 		)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &GetHistoryResponse{
+		Reports:           reports,
+		StationName:       s.Name(),
+		StationLocation:   stationLocation(closest),
+		StationDistanceKm: closest.Distance / 1000,
+	}, nil
+}
+
+// GetDailySummary summarizes the reports recorded by a station for each day, in the time zone of the station.
+func (api *API) GetDailySummary(ctx context.Context, req *GetSummaryRequest) (*GetSummaryResponse, error) {
+	return api.getSummary(req, dailyPeriod)
+}
+
+// GetHourlySummary summarizes the reports recorded by a station for each hour, in the time zone of the station.
+// It is intended for ranges too long to retrieve every report of.
+func (api *API) GetHourlySummary(ctx context.Context, req *GetSummaryRequest) (*GetSummaryResponse, error) {
+	return api.getSummary(req, hourlyPeriod)
+}
+
+func (api *API) getSummary(req *GetSummaryRequest, period summaryPeriod) (*GetSummaryResponse, error) {
+	if api.history == nil {
+		return nil, status.Error(codes.Unimplemented, "history is not being recorded")
+	}
+
+	closest, err := api.closestStation(req.Latitude, req.Longitude, req.MaxDistanceKm)
+	if err != nil {
 		return nil, err
 	}
+	s := closest.Value
 
-	return &GetForecastResponse{
-		ForecastRecords: forecast,
+	end := time.Now()
+	if req.End != nil {
+		end = req.End.AsTime()
+	}
+	if req.Start == nil || !req.Start.AsTime().Before(end) {
+		return nil, status.Error(codes.InvalidArgument, "start must be set and before end")
+	}
+
+	// Providers warn when their stations are created if the time zone will have to be approximated.
+	loc := StationTimeZone(s)
+	start, end := summaryRange(req.Start.AsTime().In(loc), end.In(loc), period)
+
+	reports, err := api.history.Query(s, start, end)
+	if err != nil {
+		api.logger.Info("error getting station history",
+			zap.String("name", s.Name()),
+			zap.Error(err),
+		)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &GetSummaryResponse{
+		Summaries:         summarizeReports(reports, loc, period),
+		StationName:       s.Name(),
+		TimeZone:          loc.String(),
+		StationLocation:   stationLocation(closest),
+		StationDistanceKm: closest.Distance / 1000,
 	}, nil
 }
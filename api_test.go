@@ -0,0 +1,703 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testStation struct {
+	name      string
+	latitude  float64
+	longitude float64
+
+	mu          sync.Mutex
+	reports     []*WeatherReport
+	alerts      []*WeatherAlert
+	refreshes   int
+	refreshedAt time.Time
+	stale       bool
+	err         error
+}
+
+func (s *testStation) Name() string {
+	return s.name
+}
+
+func (s *testStation) Latitude() float64 {
+	return s.latitude
+}
+
+func (s *testStation) Longitude() float64 {
+	return s.longitude
+}
+
+func (s *testStation) Refresh(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refreshes++
+	return nil
+}
+
+func (s *testStation) LastRefreshed() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.refreshedAt
+}
+
+func (s *testStation) NextRefresh() time.Time {
+	return time.Time{}
+}
+
+func (s *testStation) Stale() bool {
+	return s.stale
+}
+
+func (s *testStation) GetReport(ctx context.Context) (*WeatherReport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	report := s.reports[0]
+	if len(s.reports) > 1 {
+		s.reports = s.reports[1:]
+	}
+	return report, nil
+}
+
+func (s *testStation) GetForecast(ctx context.Context) ([]*WeatherForecast, error) {
+	return nil, nil
+}
+
+func (s *testStation) GetAlerts(ctx context.Context) ([]*WeatherAlert, error) {
+	return s.alerts, nil
+}
+
+type testWatchStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	max    int
+	sent   []*WatchReportResponse
+}
+
+func (s *testWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testWatchStream) Send(resp *WatchReportResponse) error {
+	s.sent = append(s.sent, resp)
+	if len(s.sent) >= s.max {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestAPI_WatchReport(t *testing.T) {
+	api := NewAPI(zap.NewNop())
+	api.watchInterval = time.Millisecond
+	api.RegisterStation(&testStation{
+		name:        "Kitchener Waterloo",
+		refreshedAt: time.Now(),
+		latitude:    43.451,
+		longitude:   -80.488,
+		reports: []*WeatherReport{
+			{ObservationId: "first"},
+			{ObservationId: "first"},
+			{ObservationId: "second"},
+			{ObservationId: "second"},
+			{ObservationId: "third"},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testWatchStream{
+		ctx:    ctx,
+		cancel: cancel,
+		max:    3,
+	}
+
+	err := api.WatchReport(&WatchReportRequest{Latitude: 43.4723, Longitude: -80.5449}, stream)
+	assert.NoError(t, err)
+	assert.Len(t, stream.sent, 3)
+
+	var ids []string
+	for _, resp := range stream.sent {
+		assert.Equal(t, "Kitchener Waterloo", resp.StationName)
+		ids = append(ids, resp.Report.ObservationId)
+	}
+	assert.Equal(t, []string{"first", "second", "third"}, ids)
+}
+
+func TestAPI_WatchReportShutdown(t *testing.T) {
+	serverCtx, shutdown := context.WithCancel(context.Background())
+
+	api := NewAPI(zap.NewNop())
+	api.watchInterval = time.Millisecond
+	api.SetContext(serverCtx)
+	api.RegisterStation(&testStation{
+		name:        "Kitchener Waterloo",
+		refreshedAt: time.Now(),
+		latitude:    43.451,
+		longitude:   -80.488,
+		reports: []*WeatherReport{
+			{ObservationId: "first"},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testWatchStream{
+		ctx:    ctx,
+		cancel: cancel,
+		max:    10,
+	}
+
+	go func() {
+		time.Sleep(time.Millisecond * 10)
+		shutdown()
+	}()
+
+	// The stream ends when the server shuts down, even though the client is still watching.
+	err := api.WatchReport(&WatchReportRequest{Latitude: 43.4723, Longitude: -80.5449}, stream)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Len(t, stream.sent, 1)
+}
+
+func TestAPI_WatchReportExpired(t *testing.T) {
+	api := NewAPI(zap.NewNop())
+	api.watchInterval = time.Millisecond
+	api.SetMaxAge(time.Hour)
+	station := &testStation{
+		name:        "Kitchener Waterloo",
+		refreshedAt: time.Now().Add(-time.Hour * 2),
+		latitude:    43.451,
+		longitude:   -80.488,
+		reports: []*WeatherReport{
+			{ObservationId: "first"},
+		},
+	}
+	api.RegisterStation(station)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &testWatchStream{
+		ctx:    ctx,
+		cancel: cancel,
+		max:    1,
+	}
+
+	// The observation isn't sent while the data has expired, but is once the station is refreshed.
+	go func() {
+		time.Sleep(time.Millisecond * 20)
+		station.mu.Lock()
+		station.refreshedAt = time.Now()
+		station.mu.Unlock()
+	}()
+
+	err := api.WatchReport(&WatchReportRequest{Latitude: 43.4723, Longitude: -80.5449}, stream)
+	assert.NoError(t, err)
+	if assert.Len(t, stream.sent, 1) {
+		assert.Equal(t, "first", stream.sent[0].Report.ObservationId)
+	}
+}
+
+func TestAPI_WatchReportNoStations(t *testing.T) {
+	api := NewAPI(zap.NewNop())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := api.WatchReport(&WatchReportRequest{}, &testWatchStream{ctx: ctx, cancel: cancel})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAPI_NoStations(t *testing.T) {
+	scheduler := NewScheduler(zap.NewNop(), time.Hour, 0, 1)
+	api := NewAPI(zap.NewNop())
+	api.SetScheduler(scheduler)
+
+	_, err := api.GetCurrentReport(context.Background(), &GetCurrentReportRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = api.GetForecast(context.Background(), &GetForecastRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = api.GetAlerts(context.Background(), &GetAlertsRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Stations can be removed while serving.
+	station := &testStation{
+		name:        "Kitchener Waterloo",
+		refreshedAt: time.Now(),
+		latitude:    43.451,
+		longitude:   -80.488,
+		reports: []*WeatherReport{
+			{ObservationId: "obs-1"},
+		},
+	}
+	api.RegisterStation(station)
+
+	resp, err := api.GetCurrentReport(context.Background(), &GetCurrentReportRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Kitchener Waterloo", resp.StationName)
+
+	// The station is no longer refreshed either.
+	api.UnregisterStation(station)
+	_, err = api.GetCurrentReport(context.Background(), &GetCurrentReportRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.False(t, scheduler.Remove(station))
+}
+
+func TestAPI_MaxDistance(t *testing.T) {
+	api := NewAPI(zap.NewNop())
+	api.RegisterStation(&testStation{
+		name:        "Kitchener Waterloo",
+		refreshedAt: time.Now(),
+		latitude:    43.451,
+		longitude:   -80.488,
+		reports: []*WeatherReport{
+			{ObservationId: "obs-1"},
+		},
+	})
+
+	// Without a limit the closest station is always returned, however far away it is.
+	tokyo := &GetCurrentReportRequest{
+		Latitude:  35.6762,
+		Longitude: 139.6503,
+	}
+	resp, err := api.GetCurrentReport(context.Background(), tokyo)
+	assert.NoError(t, err)
+	assert.InDelta(t, 10300, resp.StationDistanceKm, 100)
+
+	api.SetMaxDistance(150)
+
+	_, err = api.GetCurrentReport(context.Background(), tokyo)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = api.GetForecast(context.Background(), &GetForecastRequest{
+		Latitude:  tokyo.Latitude,
+		Longitude: tokyo.Longitude,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The request can override the default.
+	tokyo.MaxDistanceKm = 20000
+	_, err = api.GetCurrentReport(context.Background(), tokyo)
+	assert.NoError(t, err)
+
+	resp, err = api.GetCurrentReport(context.Background(), &GetCurrentReportRequest{
+		Latitude:  43.4723,
+		Longitude: -80.5449,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &GeoPoint{Latitude: 43.451, Longitude: -80.488}, resp.StationLocation)
+	assert.InDelta(t, 5.1, resp.StationDistanceKm, 0.1)
+
+	_, err = api.GetCurrentReport(context.Background(), &GetCurrentReportRequest{
+		Latitude:      43.4723,
+		Longitude:     -80.5449,
+		MaxDistanceKm: 1,
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAPI_GetCurrentReportFallback(t *testing.T) {
+	api := NewAPI(zap.NewNop())
+	api.RegisterStation(&testStation{
+		name:      "Kitchener Waterloo",
+		latitude:  43.451,
+		longitude: -80.488,
+		err:       ErrNoData,
+	})
+	api.RegisterStation(&testStation{
+		name:      "Guelph",
+		latitude:  43.5448,
+		longitude: -80.2482,
+		// The data of the station is too old to return.
+		reports: []*WeatherReport{
+			{ObservationId: "guelph"},
+		},
+	})
+	api.RegisterStation(&testStation{
+		name:        "Hamilton",
+		refreshedAt: time.Now(),
+		latitude:    43.2557,
+		longitude:   -79.8711,
+		reports: []*WeatherReport{
+			{ObservationId: "hamilton"},
+		},
+	})
+
+	req := &GetCurrentReportRequest{
+		Latitude:  43.4723,
+		Longitude: -80.5449,
+	}
+
+	resp, err := api.GetCurrentReport(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hamilton", resp.StationName)
+	assert.Equal(t, "hamilton", resp.Report.ObservationId)
+	assert.InDelta(t, 60, resp.StationDistanceKm, 1)
+
+	assert.Len(t, resp.SkippedStations, 2)
+	assert.Equal(t, "Kitchener Waterloo", resp.SkippedStations[0].StationName)
+	assert.Equal(t, ErrNoData.Error(), resp.SkippedStations[0].Reason)
+	assert.Equal(t, "Guelph", resp.SkippedStations[1].StationName)
+	assert.Equal(t, ErrDataExpired.Message(), resp.SkippedStations[1].Reason)
+	assert.Less(t, resp.SkippedStations[0].StationDistanceKm, resp.SkippedStations[1].StationDistanceKm)
+
+	// Hamilton is out of range, so the error of the closest station is returned.
+	req.MaxDistanceKm = 50
+	_, err = api.GetCurrentReport(context.Background(), req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, ErrNoData.Error(), status.Convert(err).Message())
+
+	// As it is when falling back is disabled.
+	req.MaxDistanceKm = 0
+	api.SetFallbackStations(1)
+	_, err = api.GetCurrentReport(context.Background(), req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestAPI_GetCurrentReportInterpolated(t *testing.T) {
+	api := NewAPI(zap.NewNop())
+	api.RegisterStation(&testStation{
+		name:        "Kitchener Waterloo",
+		refreshedAt: time.Now(),
+		latitude:    43.451,
+		longitude:   -80.488,
+		reports: []*WeatherReport{
+			{
+				ObservationId: "kitchener",
+				Conditions: &WeatherCondition{
+					SummaryIcon: WeatherIcon_SNOW,
+					Summary:     "Light Snow",
+					Temperature: -2,
+					Humidity:    80,
+				},
+			},
+		},
+	})
+	api.RegisterStation(&testStation{
+		name:        "Guelph",
+		refreshedAt: time.Now(),
+		stale:       true,
+		latitude:    43.5448,
+		longitude:   -80.2482,
+		reports: []*WeatherReport{
+			{
+				ObservationId: "guelph",
+				Conditions: &WeatherCondition{
+					SummaryIcon: WeatherIcon_CLOUDY,
+					Summary:     "Cloudy",
+					Temperature: 2,
+					Humidity:    60,
+				},
+			},
+		},
+	})
+	api.RegisterStation(&testStation{
+		name:      "Hamilton",
+		latitude:  43.2557,
+		longitude: -79.8711,
+		err:       ErrNoData,
+	})
+
+	// Halfway between Kitchener and Guelph.
+	req := &GetCurrentReportRequest{
+		Latitude:  43.4979,
+		Longitude: -80.3681,
+		Mode:      ReportMode_INTERPOLATED,
+	}
+	resp, err := api.GetCurrentReport(context.Background(), req)
+	assert.NoError(t, err)
+
+	assert.Len(t, resp.ContributingStations, 2)
+	assert.Equal(t, resp.StationName, resp.ContributingStations[0].StationName)
+	assert.InDelta(t, 0.5, resp.ContributingStations[0].Weight, 0.01)
+	assert.InDelta(t, 0.5, resp.ContributingStations[1].Weight, 0.01)
+	assert.InDelta(t, 0, resp.Report.Conditions.Temperature, 0.1)
+	assert.Equal(t, int32(70), resp.Report.Conditions.Humidity)
+	assert.True(t, resp.Stale)
+
+	assert.Len(t, resp.SkippedStations, 1)
+	assert.Equal(t, "Hamilton", resp.SkippedStations[0].StationName)
+
+	// Close to Kitchener, which dominates the result.
+	req.Latitude = 43.4723
+	req.Longitude = -80.5449
+	req.InterpolationStations = 2
+	resp, err = api.GetCurrentReport(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "Kitchener Waterloo", resp.StationName)
+	assert.Equal(t, WeatherIcon_SNOW, resp.Report.Conditions.SummaryIcon)
+	assert.Greater(t, resp.ContributingStations[0].Weight, 0.9)
+	assert.Less(t, resp.Report.Conditions.Temperature, float32(-1.5))
+	assert.Empty(t, resp.SkippedStations)
+}
+
+func TestAPI_GetAlerts(t *testing.T) {
+	api := NewAPI(zap.NewNop())
+	api.RegisterStation(&testStation{
+		name:        "San Francisco",
+		refreshedAt: time.Now(),
+		latitude:    37.7749,
+		longitude:   -122.4194,
+		alerts: []*WeatherAlert{
+			{
+				AlertId: "zone",
+				Area:    "San Francisco",
+			},
+			{
+				AlertId: "covering",
+				Polygon: []*GeoPoint{
+					{Latitude: 37.70, Longitude: -122.52},
+					{Latitude: 37.70, Longitude: -122.35},
+					{Latitude: 37.83, Longitude: -122.35},
+					{Latitude: 37.83, Longitude: -122.52},
+					{Latitude: 37.70, Longitude: -122.52},
+				},
+			},
+			{
+				AlertId: "elsewhere",
+				Polygon: []*GeoPoint{
+					{Latitude: 37.30, Longitude: -122.10},
+					{Latitude: 37.30, Longitude: -121.80},
+					{Latitude: 37.45, Longitude: -121.80},
+					{Latitude: 37.45, Longitude: -122.10},
+					{Latitude: 37.30, Longitude: -122.10},
+				},
+			},
+			{
+				// Only the second of the areas covers the location.
+				AlertId: "multiple",
+				Polygon: []*GeoPoint{
+					{Latitude: 37.30, Longitude: -122.10},
+					{Latitude: 37.30, Longitude: -121.80},
+					{Latitude: 37.45, Longitude: -121.80},
+				},
+				Polygons: []*GeoPolygon{
+					{Points: []*GeoPoint{
+						{Latitude: 37.30, Longitude: -122.10},
+						{Latitude: 37.30, Longitude: -121.80},
+						{Latitude: 37.45, Longitude: -121.80},
+					}},
+					{Points: []*GeoPoint{
+						{Latitude: 37.75, Longitude: -122.45},
+						{Latitude: 37.75, Longitude: -122.40},
+						{Latitude: 37.80, Longitude: -122.40},
+						{Latitude: 37.80, Longitude: -122.45},
+					}},
+				},
+			},
+		},
+	})
+
+	resp, err := api.GetAlerts(context.Background(), &GetAlertsRequest{Latitude: 37.7793, Longitude: -122.4193})
+	assert.NoError(t, err)
+	assert.Equal(t, "San Francisco", resp.StationName)
+
+	var ids []string
+	for _, alert := range resp.Alerts {
+		ids = append(ids, alert.AlertId)
+	}
+	assert.Equal(t, []string{"zone", "covering", "multiple"}, ids)
+}
+
+func TestAPI_GetCurrentReportStale(t *testing.T) {
+	errUpstream := errors.New("upstream unavailable")
+	failing := false
+
+	api := NewAPI(zap.NewNop())
+	station := &cachedStation{
+		name: "Kitchener",
+		StationCache: NewStationCache(func(ctx context.Context, previous *StationData) (*StationData, error) {
+			if failing {
+				return nil, errUpstream
+			}
+			return &StationData{
+				Report: &WeatherReport{
+					ObservationId: "obs-1",
+				},
+				RefreshedAt: time.Now().Add(-time.Minute * 35),
+			}, nil
+		}),
+	}
+	api.RegisterStation(station)
+
+	req := &GetCurrentReportRequest{
+		Latitude:  43.4516,
+		Longitude: -80.4925,
+	}
+
+	err := station.Refresh(context.Background())
+	assert.NoError(t, err)
+
+	resp, err := api.GetCurrentReport(context.Background(), req)
+	assert.NoError(t, err)
+	assert.False(t, resp.Stale)
+
+	// The last good report continues to be returned when the upstream provider fails, marked as stale.
+	failing = true
+	err = station.Refresh(context.Background())
+	assert.ErrorIs(t, err, errUpstream)
+
+	resp, err = api.GetCurrentReport(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "obs-1", resp.Report.ObservationId)
+	assert.True(t, resp.Stale)
+	assert.GreaterOrEqual(t, resp.Age.AsDuration(), time.Minute*35)
+
+	// Once the report is older than the max age it is no longer returned.
+	api.SetMaxAge(time.Minute * 30)
+	_, err = api.GetCurrentReport(context.Background(), req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestAPI_Offline(t *testing.T) {
+	offline := NewOfflineSwitch(zap.NewNop(), false)
+	admin := NewAdminAPI(zap.NewNop(), offline)
+
+	api := NewAPI(zap.NewNop())
+	api.SetMaxAge(time.Hour)
+	api.SetOfflineSwitch(offline)
+	api.RegisterStation(&testStation{
+		name:        "Kitchener Waterloo",
+		latitude:    43.451,
+		longitude:   -80.488,
+		refreshedAt: time.Now().Add(-time.Hour * 24),
+		reports: []*WeatherReport{
+			{ObservationId: "yesterday"},
+		},
+	})
+
+	req := &GetCurrentReportRequest{
+		Latitude:  43.4723,
+		Longitude: -80.5449,
+	}
+
+	_, err := api.GetCurrentReport(context.Background(), req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// While offline, data is returned regardless of its age.
+	mode, err := admin.SetOfflineMode(context.Background(), &SetOfflineModeRequest{Offline: true})
+	assert.NoError(t, err)
+	assert.True(t, mode.Offline)
+
+	resp, err := api.GetCurrentReport(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, resp.Offline)
+	assert.Equal(t, "yesterday", resp.Report.ObservationId)
+	assert.GreaterOrEqual(t, resp.Age.AsDuration(), time.Hour*24)
+
+	mode, err = admin.SetOfflineMode(context.Background(), &SetOfflineModeRequest{Offline: false})
+	assert.NoError(t, err)
+	assert.False(t, mode.Offline)
+}
+
+func TestAPI_GetHistory(t *testing.T) {
+	api := NewAPI(zap.NewNop())
+	station := &testStation{
+		name:      "Kitchener Waterloo",
+		latitude:  43.451,
+		longitude: -80.488,
+	}
+	api.RegisterStation(station)
+
+	req := &GetHistoryRequest{
+		Latitude:  43.4723,
+		Longitude: -80.5449,
+		Start:     timestamppb.New(time.Date(2024, time.November, 20, 0, 0, 0, 0, time.UTC)),
+		End:       timestamppb.New(time.Date(2024, time.November, 21, 0, 0, 0, 0, time.UTC)),
+	}
+
+	_, err := api.GetHistory(context.Background(), req)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	history, err := NewHistoryStore(zap.NewNop(), t.TempDir(), 0)
+	assert.NoError(t, err)
+	api.SetHistoryStore(history)
+
+	assert.NoError(t, history.Record(station, &WeatherReport{
+		ObservationId: "overnight",
+		ObservedAt:    timestamppb.New(time.Date(2024, time.November, 20, 3, 0, 0, 0, time.UTC)),
+	}))
+
+	resp, err := api.GetHistory(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "Kitchener Waterloo", resp.StationName)
+	assert.Len(t, resp.Reports, 1)
+	assert.Equal(t, "overnight", resp.Reports[0].ObservationId)
+
+	_, err = api.GetHistory(context.Background(), &GetHistoryRequest{
+		Latitude:  43.4723,
+		Longitude: -80.5449,
+		Start:     req.End,
+		End:       req.Start,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAPI_GetDailySummary(t *testing.T) {
+	api := NewAPI(zap.NewNop())
+	station := &testStation{
+		name:      "Kitchener Waterloo",
+		latitude:  43.451,
+		longitude: -80.488,
+	}
+	api.RegisterStation(station)
+
+	history, err := NewHistoryStore(zap.NewNop(), t.TempDir(), 0)
+	assert.NoError(t, err)
+	api.SetHistoryStore(history)
+
+	for i, temp := range []float32{-2, 1, 4} {
+		assert.NoError(t, history.Record(station, &WeatherReport{
+			ObservationId: fmt.Sprintf("obs-%d", i),
+			ObservedAt:    timestamppb.New(time.Date(2024, time.November, 20, 3+i*4, 0, 0, 0, time.UTC)),
+			Conditions: &WeatherCondition{
+				Temperature: temp,
+			},
+		}))
+	}
+
+	// The station doesn't know its time zone so it is approximated as UTC-5; the first report is on the 19th.
+	resp, err := api.GetDailySummary(context.Background(), &GetSummaryRequest{
+		Latitude:  43.4723,
+		Longitude: -80.5449,
+		Start:     timestamppb.New(time.Date(2024, time.November, 19, 12, 0, 0, 0, time.UTC)),
+		End:       timestamppb.New(time.Date(2024, time.November, 21, 0, 0, 0, 0, time.UTC)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Kitchener Waterloo", resp.StationName)
+	assert.Equal(t, "UTC-5", resp.TimeZone)
+	assert.Len(t, resp.Summaries, 2)
+	assert.Equal(t, int32(1), resp.Summaries[0].ReportCount)
+	assert.Equal(t, float32(-2), resp.Summaries[0].MeanTemperature)
+	assert.Equal(t, int32(2), resp.Summaries[1].ReportCount)
+	assert.Equal(t, float32(2.5), resp.Summaries[1].MeanTemperature)
+
+	hourly, err := api.GetHourlySummary(context.Background(), &GetSummaryRequest{
+		Latitude:  43.4723,
+		Longitude: -80.5449,
+		Start:     timestamppb.New(time.Date(2024, time.November, 20, 6, 30, 0, 0, time.UTC)),
+		End:       timestamppb.New(time.Date(2024, time.November, 20, 11, 0, 0, 0, time.UTC)),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, hourly.Summaries, 1)
+	assert.Equal(t, float32(1), hourly.Summaries[0].MaxTemperature)
+}
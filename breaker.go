@@ -0,0 +1,150 @@
+package weather
+
+import (
+	"expvar"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// upstreamMetrics contains the request counts and circuit breaker state of each upstream host, published at
+// /debug/vars under "upstream" when the expvar handler is served.
+var upstreamMetrics = expvar.NewMap("upstream")
+
+type breakerState int
+
+const (
+	// breakerClosed allows every request through.
+	breakerClosed breakerState = iota
+	// breakerOpen rejects every request until the cooldown has elapsed.
+	breakerOpen
+	// breakerHalfOpen allows a single trial request through to determine whether the host has recovered.
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker stops requests being made to an upstream host after repeated failures,
+// so that an outage isn't made worse by every station retrying against it.
+type circuitBreaker struct {
+	logger  *zap.Logger
+	host    string
+	metrics *expvar.Map
+
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+func newCircuitBreaker(logger *zap.Logger, host string, threshold int, cooldown time.Duration) *circuitBreaker {
+	// Clients for the same host share their metrics.
+	metrics, ok := upstreamMetrics.Get(host).(*expvar.Map)
+	if !ok {
+		metrics = new(expvar.Map).Init()
+		upstreamMetrics.Set(host, metrics)
+	}
+
+	b := &circuitBreaker{
+		logger:    logger,
+		host:      host,
+		metrics:   metrics,
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+	b.publishState()
+	return b
+}
+
+// allow returns true if a request may be made to the host.
+func (b *circuitBreaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if now.Sub(b.openedAt) < b.cooldown {
+			b.metrics.Add("rejected", 1)
+			return false
+		}
+		b.setState(breakerHalfOpen)
+		b.trial = true
+		return true
+	case breakerHalfOpen:
+		if b.trial {
+			b.metrics.Add("rejected", 1)
+			return false
+		}
+		b.trial = true
+		return true
+	default:
+		return true
+	}
+}
+
+// success records a request which the host handled.
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.trial = false
+	if b.state != breakerClosed {
+		b.setState(breakerClosed)
+	}
+}
+
+// failure records a request which failed because of the host.
+func (b *circuitBreaker) failure(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.metrics.Add("failures", 1)
+	if b.state == breakerHalfOpen || (b.state == breakerClosed && b.failures >= b.threshold) {
+		b.openedAt = now
+		b.trial = false
+		b.metrics.Add("opened", 1)
+		b.setState(breakerOpen)
+	}
+}
+
+// abandon records a request which was given up on by the caller before the host responded.
+func (b *circuitBreaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+}
+
+func (b *circuitBreaker) setState(state breakerState) {
+	b.logger.Info("circuit breaker state changed",
+		zap.String("host", b.host),
+		zap.Stringer("from", b.state),
+		zap.Stringer("to", state),
+		zap.Int("failures", b.failures),
+	)
+
+	b.state = state
+	b.publishState()
+}
+
+func (b *circuitBreaker) publishState() {
+	state := new(expvar.String)
+	state.Set(b.state.String())
+	b.metrics.Set("breaker_state", state)
+}
@@ -0,0 +1,197 @@
+package weather
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// StationData is the data retrieved from an upstream provider by a single refresh of a station.
+type StationData struct {
+	Report      *WeatherReport
+	Forecast    []*WeatherForecast
+	Alerts      []*WeatherAlert
+	RefreshedAt time.Time
+	// Expires is when the upstream provider indicated the data will be out of date, or zero if it didn't say.
+	Expires time.Time
+	// Stale is set if some of the data couldn't be retrieved, and was carried over from the previous refresh instead.
+	// RefreshedAt is then that of the carried over data, so that the age of the data is that of its oldest part.
+	Stale bool
+}
+
+// FetchFunc retrieves the latest data for a station from its upstream provider.
+// The previously retrieved data, which is nil if there is none, is supplied so that a partial failure can fall back
+// to it. Returning nil data without an error leaves the cached data unchanged.
+type FetchFunc func(ctx context.Context, previous *StationData) (*StationData, error)
+
+// refreshCall is a single fetch in progress, whose result is shared with every caller waiting on it.
+type refreshCall struct {
+	done    chan struct{}
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// StationCache holds the most recently retrieved data of a station, and is safe for concurrent use.
+// Concurrent refreshes are coalesced into a single fetch from the upstream provider.
+// It implements every method of Station other than the station's name and location, as well as Snapshotter,
+// so that it can be embedded by implementations.
+type StationCache struct {
+	fetch FetchFunc
+
+	mu      sync.RWMutex
+	data    *StationData
+	stale   bool
+	pending *refreshCall
+}
+
+// NewStationCache creates a new, empty cache which is filled using the supplied fetch function.
+func NewStationCache(fetch FetchFunc) *StationCache {
+	return &StationCache{
+		fetch: fetch,
+	}
+}
+
+// Refresh fetches the latest data from the upstream provider.
+// If a fetch is already in progress, this waits for it to complete and returns its result instead of starting another.
+// The fetch is cancelled once every caller waiting on it has been cancelled, and Refresh doesn't return until
+// it has stopped, so a fetch never outlives its callers.
+func (c *StationCache) Refresh(ctx context.Context) error {
+	c.mu.Lock()
+	call := c.pending
+	if call == nil {
+		// The fetch is shared by every waiter, so it isn't cancelled when the caller which started it goes away.
+		fetchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &refreshCall{
+			done:   make(chan struct{}),
+			cancel: cancel,
+		}
+		c.pending = call
+		go c.doFetch(fetchCtx, call, c.data)
+	}
+	call.waiters++
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+	}
+
+	c.mu.Lock()
+	call.waiters--
+	abandoned := call.waiters < 1
+	c.mu.Unlock()
+
+	if abandoned {
+		call.cancel()
+		<-call.done
+	}
+	return ctx.Err()
+}
+
+func (c *StationCache) doFetch(ctx context.Context, call *refreshCall, previous *StationData) {
+	data, err := c.fetch(ctx, previous)
+	call.cancel()
+
+	c.mu.Lock()
+	if err == nil && data != nil {
+		c.data = data
+	}
+	c.stale = err != nil || (data != nil && data.Stale)
+	call.err = err
+	c.pending = nil
+	c.mu.Unlock()
+
+	close(call.done)
+}
+
+// Data returns the cached data, or ErrNoData if nothing has been retrieved yet.
+// It never fetches from the upstream provider itself, so that requests don't bypass the limit the scheduler places
+// on concurrent fetches.
+func (c *StationCache) Data(ctx context.Context) (*StationData, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.data == nil {
+		return nil, ErrNoData
+	}
+	return c.data, nil
+}
+
+// Snapshot returns the cached data, or nil if nothing has been retrieved.
+func (c *StationCache) Snapshot() *StationData {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.data
+}
+
+// Restore fills the cache with previously retrieved data, unless the cache already holds newer data.
+func (c *StationCache) Restore(data *StationData) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.data == nil || c.data.RefreshedAt.Before(data.RefreshedAt) {
+		c.data = data
+		c.stale = data.Stale
+	}
+}
+
+// LastRefreshed returns the time the cached data was retrieved, or the zero time if nothing has been retrieved.
+func (c *StationCache) LastRefreshed() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.data == nil {
+		return time.Time{}
+	}
+	return c.data.RefreshedAt
+}
+
+// NextRefresh returns when the cached data is expected to be out of date, or the zero time if this isn't known.
+func (c *StationCache) NextRefresh() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.data == nil {
+		return time.Time{}
+	}
+	return c.data.Expires
+}
+
+// Stale returns true if the most recent refresh failed, or only partially succeeded, so the cached data is older
+// than expected.
+func (c *StationCache) Stale() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.stale
+}
+
+// GetReport returns the cached weather report.
+func (c *StationCache) GetReport(ctx context.Context) (*WeatherReport, error) {
+	data, err := c.Data(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return data.Report, nil
+}
+
+// GetForecast returns the cached forecast.
+func (c *StationCache) GetForecast(ctx context.Context) ([]*WeatherForecast, error) {
+	data, err := c.Data(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return data.Forecast, nil
+}
+
+// GetAlerts returns the cached alerts.
+func (c *StationCache) GetAlerts(ctx context.Context) ([]*WeatherAlert, error) {
+	data, err := c.Data(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return data.Alerts, nil
+}
@@ -0,0 +1,227 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cachedStation is a station backed by a StationCache, as the provider implementations are.
+type cachedStation struct {
+	*StationCache
+
+	name string
+}
+
+func (s *cachedStation) Name() string {
+	return s.name
+}
+
+func (s *cachedStation) Latitude() float64 {
+	return 43.4516
+}
+
+func (s *cachedStation) Longitude() float64 {
+	return -80.4925
+}
+
+func TestStationCache_GetCurrentReportCoalesced(t *testing.T) {
+	var fetches atomic.Int32
+	release := make(chan struct{})
+
+	station := &cachedStation{
+		name: "Kitchener",
+		StationCache: NewStationCache(func(ctx context.Context, previous *StationData) (*StationData, error) {
+			fetches.Add(1)
+			<-release
+			return &StationData{
+				Report: &WeatherReport{
+					ObservationId: "obs-1",
+				},
+				RefreshedAt: time.Now(),
+			}, nil
+		}),
+	}
+
+	api := NewAPI(zap.NewNop())
+	api.RegisterStation(station)
+
+	req := &GetCurrentReportRequest{
+		Latitude:  43.4516,
+		Longitude: -80.4925,
+	}
+
+	// Requests only read the cache, so nothing is fetched until the station is refreshed.
+	_, err := api.GetCurrentReport(context.Background(), req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(0), fetches.Load())
+
+	// Every request is answered once the refreshes it waits on, which share a single fetch, complete.
+	var wg sync.WaitGroup
+	responses := make([]*GetCurrentReportResponse, 100)
+	for i := range responses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			err := station.Refresh(context.Background())
+			assert.NoError(t, err)
+
+			resp, err := api.GetCurrentReport(context.Background(), req)
+			assert.NoError(t, err)
+			responses[i] = resp
+		}(i)
+	}
+
+	// Give every refresh a chance to wait on the in progress fetch before it completes.
+	time.Sleep(time.Millisecond * 20)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), fetches.Load())
+	for _, resp := range responses {
+		assert.Equal(t, "obs-1", resp.Report.ObservationId)
+	}
+
+	// Once filled, reads don't fetch again.
+	_, err = station.GetReport(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+}
+
+func TestStationCache_GetCurrentReportDuringRefresh(t *testing.T) {
+	var fetches atomic.Int32
+	station := &cachedStation{
+		name: "Kitchener",
+		StationCache: NewStationCache(func(ctx context.Context, previous *StationData) (*StationData, error) {
+			n := fetches.Add(1)
+			time.Sleep(time.Millisecond)
+			return &StationData{
+				Report: &WeatherReport{
+					ObservationId: fmt.Sprintf("obs-%d", n),
+				},
+				RefreshedAt: time.Now(),
+			}, nil
+		}),
+	}
+
+	api := NewAPI(zap.NewNop())
+	api.RegisterStation(station)
+	assert.NoError(t, station.Refresh(context.Background()))
+
+	req := &GetCurrentReportRequest{
+		Latitude:  43.4516,
+		Longitude: -80.4925,
+	}
+
+	// Requests are answered from the previous data while the station is being refreshed.
+	var stop atomic.Bool
+	refreshed := make(chan struct{})
+	go func() {
+		defer close(refreshed)
+		for !stop.Load() {
+			assert.NoError(t, station.Refresh(context.Background()))
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 10; j++ {
+				resp, err := api.GetCurrentReport(context.Background(), req)
+				if assert.NoError(t, err) {
+					assert.NotEmpty(t, resp.Report.ObservationId)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Eventually(t, func() bool {
+		return fetches.Load() > 2
+	}, time.Second, time.Millisecond)
+	stop.Store(true)
+	<-refreshed
+}
+
+func TestStationCache_Refresh(t *testing.T) {
+	errUpstream := errors.New("upstream unavailable")
+
+	var fetches atomic.Int32
+	cache := NewStationCache(func(ctx context.Context, previous *StationData) (*StationData, error) {
+		if fetches.Add(1) == 2 {
+			return nil, errUpstream
+		}
+		return &StationData{
+			Report: &WeatherReport{
+				ObservationId: "obs-1",
+			},
+			RefreshedAt: time.Now(),
+		}, nil
+	})
+
+	err := cache.Refresh(context.Background())
+	assert.NoError(t, err)
+
+	// A failed refresh keeps the previously retrieved data.
+	err = cache.Refresh(context.Background())
+	assert.ErrorIs(t, err, errUpstream)
+
+	report, err := cache.GetReport(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "obs-1", report.ObservationId)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+func TestStationCache_RefreshCancelled(t *testing.T) {
+	started := make(chan struct{})
+	var stopped atomic.Bool
+
+	cache := NewStationCache(func(ctx context.Context, previous *StationData) (*StationData, error) {
+		close(started)
+		<-ctx.Done()
+		stopped.Store(true)
+		return nil, ctx.Err()
+	})
+
+	firstCtx, firstCancel := context.WithCancel(context.Background())
+	secondCtx, secondCancel := context.WithCancel(context.Background())
+	defer secondCancel()
+
+	firstErr := make(chan error)
+	go func() {
+		firstErr <- cache.Refresh(firstCtx)
+	}()
+	<-started
+
+	secondErr := make(chan error)
+	go func() {
+		secondErr <- cache.Refresh(secondCtx)
+	}()
+	assert.Eventually(t, func() bool {
+		cache.mu.RLock()
+		defer cache.mu.RUnlock()
+		return cache.pending != nil && cache.pending.waiters == 2
+	}, time.Second, time.Millisecond)
+
+	// A waiter can give up without cancelling the fetch others are still waiting on.
+	firstCancel()
+	assert.ErrorIs(t, <-firstErr, context.Canceled)
+	assert.False(t, stopped.Load())
+
+	// Once the last waiter gives up the fetch is cancelled, and has stopped by the time Refresh returns.
+	secondCancel()
+	assert.ErrorIs(t, <-secondErr, context.Canceled)
+	assert.True(t, stopped.Load())
+}
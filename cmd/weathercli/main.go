@@ -13,15 +13,25 @@ import (
 
 const (
 	envVarWeatherdEndpoint = "WEATHERD_ENDPOINT"
+	envVarAdminEndpoint    = "ADMIN_ENDPOINT"
 	envVarLatitude         = "LATITUDE"
 	envVarLongitude        = "LONGITUDE"
+	envVarWatch            = "WATCH"
+	envVarOffline          = "OFFLINE"
+	envVarMaxDistance      = "MAX_DISTANCE_KM"
+	envVarInterpolate      = "INTERPOLATE"
 )
 
 func main() {
 	viper.SetEnvPrefix("NVS")
 	viper.BindEnv(envVarWeatherdEndpoint)
+	viper.BindEnv(envVarAdminEndpoint)
 	viper.BindEnv(envVarLatitude)
 	viper.BindEnv(envVarLongitude)
+	viper.BindEnv(envVarWatch)
+	viper.BindEnv(envVarOffline)
+	viper.BindEnv(envVarMaxDistance)
+	viper.BindEnv(envVarInterpolate)
 
 	logger, err := zap.NewDevelopment()
 	if err != nil {
@@ -41,9 +51,22 @@ func main() {
 	defer weatherConn.Close()
 
 	weatherClient := weather.NewWeatherServiceClient(weatherConn)
+
+	// Switch weatherd between offline and online mode when NVS_OFFLINE is set, using the admin listener
+	// at NVS_ADMIN_ENDPOINT, i.e. localhost:10103 or unix:/run/weatherd/admin.sock.
+	if viper.IsSet(envVarOffline) {
+		setOfflineMode(logger, grpcOpts, viper.GetBool(envVarOffline))
+	}
+	// Blend the reports of the closest stations when NVS_INTERPOLATE is set.
+	mode := weather.ReportMode_CLOSEST
+	if viper.GetBool(envVarInterpolate) {
+		mode = weather.ReportMode_INTERPOLATED
+	}
 	report, err := weatherClient.GetCurrentReport(context.Background(), &weather.GetCurrentReportRequest{
-		Latitude:  viper.GetFloat64(envVarLatitude),
-		Longitude: viper.GetFloat64(envVarLongitude),
+		Latitude:      viper.GetFloat64(envVarLatitude),
+		Longitude:     viper.GetFloat64(envVarLongitude),
+		MaxDistanceKm: viper.GetFloat64(envVarMaxDistance),
+		Mode:          mode,
 	})
 	if err != nil {
 		logger.Warn("unable to get weather report")
@@ -52,12 +75,71 @@ func main() {
 	spew.Dump(report)
 
 	forecast, err := weatherClient.GetForecast(context.Background(), &weather.GetForecastRequest{
-		Latitude:  viper.GetFloat64(envVarLatitude),
-		Longitude: viper.GetFloat64(envVarLongitude),
+		Latitude:      viper.GetFloat64(envVarLatitude),
+		Longitude:     viper.GetFloat64(envVarLongitude),
+		MaxDistanceKm: viper.GetFloat64(envVarMaxDistance),
 	})
 	if err != nil {
 		logger.Warn("unable to get weather forecast")
 	}
 
 	spew.Dump(forecast)
+
+	alerts, err := weatherClient.GetAlerts(context.Background(), &weather.GetAlertsRequest{
+		Latitude:      viper.GetFloat64(envVarLatitude),
+		Longitude:     viper.GetFloat64(envVarLongitude),
+		MaxDistanceKm: viper.GetFloat64(envVarMaxDistance),
+	})
+	if err != nil {
+		logger.Warn("unable to get weather alerts")
+	}
+
+	spew.Dump(alerts)
+
+	if !viper.GetBool(envVarWatch) {
+		return
+	}
+
+	stream, err := weatherClient.WatchReport(context.Background(), &weather.WatchReportRequest{
+		Latitude:      viper.GetFloat64(envVarLatitude),
+		Longitude:     viper.GetFloat64(envVarLongitude),
+		MaxDistanceKm: viper.GetFloat64(envVarMaxDistance),
+	})
+	if err != nil {
+		logger.Warn("unable to watch weather reports")
+		return
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			logger.Warn("weather report stream closed",
+				zap.Error(err),
+			)
+			return
+		}
+
+		spew.Dump(update)
+	}
+}
+
+func setOfflineMode(logger *zap.Logger, grpcOpts []grpc.DialOption, offline bool) {
+	adminConn, err := grpc.NewClient(viper.GetString(envVarAdminEndpoint), grpcOpts...)
+	if err != nil {
+		logger.Warn("unable to dial admin server",
+			zap.String("endpoint", viper.GetString(envVarAdminEndpoint)),
+			zap.Error(err),
+		)
+		return
+	}
+	defer adminConn.Close()
+
+	mode, err := weather.NewAdminServiceClient(adminConn).SetOfflineMode(context.Background(), &weather.SetOfflineModeRequest{
+		Offline: offline,
+	})
+	if err != nil {
+		logger.Warn("unable to set offline mode")
+	}
+
+	spew.Dump(mode)
 }
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// errAdminNotLocal is returned if the admin listener would be reachable from other hosts.
+var errAdminNotLocal = errors.New("admin addr must be a loopback address or a unix socket")
+
+// listenAdmin listens on the supplied admin address, which is either a "unix:" prefixed socket path or a TCP address
+// on a loopback interface, so that only operators on this host can reach the AdminService.
+func listenAdmin(addr string) (net.Listener, error) {
+	if path, ok := strings.CutPrefix(addr, "unix:"); ok {
+		// A socket left behind by an unclean shutdown would otherwise prevent listening.
+		err := os.Remove(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		return net.Listen("unix", path)
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	if host != "localhost" {
+		ip := net.ParseIP(host)
+		if ip == nil || !ip.IsLoopback() {
+			return nil, fmt.Errorf("%w: %q", errAdminNotLocal, addr)
+		}
+	}
+	return net.Listen("tcp", addr)
+}
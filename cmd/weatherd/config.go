@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rmrobinson/weather"
+	"github.com/rmrobinson/weather/envcan"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// httpConfig reads the HTTP configuration for the named provider from the "<provider>.http" keys,
+// falling back to the shared "http" keys and then the provider defaults for any values which aren't set.
+func httpConfig(provider string, defaults weather.HTTPConfig) weather.HTTPConfig {
+	getString := func(key string, defaultValue string) string {
+		if val := viper.GetString(provider + ".http." + key); len(val) > 0 {
+			return val
+		} else if val := viper.GetString("http." + key); len(val) > 0 {
+			return val
+		}
+		return defaultValue
+	}
+
+	headers := map[string]string{}
+	for key, value := range defaults.Headers {
+		headers[key] = value
+	}
+	for key, value := range viper.GetStringMapString("http.headers") {
+		headers[key] = value
+	}
+	for key, value := range viper.GetStringMapString(provider + ".http.headers") {
+		headers[key] = value
+	}
+
+	getDuration := func(key string, defaultValue time.Duration) time.Duration {
+		if val := viper.GetDuration(provider + ".http." + key); val > 0 {
+			return val
+		} else if val := viper.GetDuration("http." + key); val > 0 {
+			return val
+		}
+		return defaultValue
+	}
+	getInt := func(key string, defaultValue int) int {
+		if val := viper.GetInt(provider + ".http." + key); val > 0 {
+			return val
+		} else if val := viper.GetInt("http." + key); val > 0 {
+			return val
+		}
+		return defaultValue
+	}
+
+	return weather.HTTPConfig{
+		UserAgent:        getString("user_agent", defaults.UserAgent),
+		Accept:           getString("accept", defaults.Accept),
+		Headers:          headers,
+		Timeout:          getDuration("timeout", defaults.Timeout),
+		MaxAttempts:      getInt("max_attempts", defaults.MaxAttempts),
+		RetryBackoff:     getDuration("retry_backoff", defaults.RetryBackoff),
+		MaxRetryBackoff:  getDuration("max_retry_backoff", defaults.MaxRetryBackoff),
+		BreakerThreshold: getInt("breaker_threshold", defaults.BreakerThreshold),
+		BreakerCooldown:  getDuration("breaker_cooldown", defaults.BreakerCooldown),
+	}
+}
+
+// stationEntry is the configuration of a single station, along with where it was read from for reporting errors.
+type stationEntry struct {
+	source string
+	config weather.StationConfig
+}
+
+// loadStationConfigs reads the stations listed under the "stations" key of the config file.
+func loadStationConfigs(logger *zap.Logger) []stationEntry {
+	var configs []map[string]interface{}
+	err := viper.UnmarshalKey("stations", &configs)
+	if err != nil {
+		logger.Warn("error reading stations from config",
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	var entries []stationEntry
+	for idx, config := range configs {
+		entries = append(entries, stationEntry{
+			source: fmt.Sprintf("%s: stations[%d]", viper.ConfigFileUsed(), idx),
+			config: config,
+		})
+	}
+
+	return entries
+}
+
+// timeZoneOverride sets the time zone of a station loaded from the envcan map, as the map doesn't include it.
+type timeZoneOverride struct {
+	Name     string `mapstructure:"name"`
+	TimeZone string `mapstructure:"time_zone"`
+}
+
+// loadEnvCanMap reads the JSON-lines file produced by envcan/cmd/getstations.
+// Lines which can't be parsed are logged with their line number and skipped.
+// The time zones listed under the "envcan_time_zones" key are applied to the stations of the same name.
+func loadEnvCanMap(logger *zap.Logger, path string) ([]stationEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var overrides []timeZoneOverride
+	err = viper.UnmarshalKey("envcan_time_zones", &overrides)
+	if err != nil {
+		return nil, err
+	}
+	timeZones := map[string]string{}
+	for _, override := range overrides {
+		timeZones[override.Name] = override.TimeZone
+	}
+
+	var entries []stationEntry
+
+	scanner := bufio.NewScanner(f)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) < 1 {
+			continue
+		}
+
+		config := weather.StationConfig{}
+		err = json.Unmarshal([]byte(line), &config)
+		if err != nil {
+			logger.Warn("skipping invalid station in envcan map",
+				zap.String("path", path),
+				zap.Int("line", lineNumber),
+				zap.Error(err),
+			)
+			continue
+		}
+		config["type"] = envcan.ProviderName
+		if timeZone, ok := timeZones[config.String("name")]; ok {
+			config["time_zone"] = timeZone
+		}
+
+		entries = append(entries, stationEntry{
+			source: fmt.Sprintf("%s:%d", path, lineNumber),
+			config: config,
+		})
+	}
+
+	return entries, scanner.Err()
+}
+
+// newStations creates the configured stations using the registered providers.
+// Entries which can't be created are logged and skipped.
+func newStations(logger *zap.Logger, entries []stationEntry, offline *weather.OfflineSwitch) []weather.Station {
+	clients := map[string]*weather.HTTPClient{}
+
+	var stations []weather.Station
+	for _, entry := range entries {
+		providerName := entry.config.String("type")
+		provider, err := weather.LookupProvider(providerName)
+		if err != nil {
+			logger.Warn("skipping invalid station",
+				zap.String("source", entry.source),
+				zap.Strings("providers", weather.Providers()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		// Stations of the same provider share a client, as they share the same upstream.
+		client, ok := clients[providerName]
+		if !ok {
+			client = weather.NewHTTPClient(logger, httpConfig(providerName, provider.HTTPConfig))
+			client.SetOfflineSwitch(offline)
+			clients[providerName] = client
+		}
+
+		station, err := provider.NewStation(logger, client, entry.config)
+		if err != nil {
+			logger.Warn("skipping invalid station",
+				zap.String("source", entry.source),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		stations = append(stations, station)
+	}
+
+	return stations
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rmrobinson/weather"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLoadEnvCanMap(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	path := filepath.Join(t.TempDir(), "envcan.json")
+	err := os.WriteFile(path, []byte(`{"name": "Kitchener-Waterloo", "url": "https://weather.gc.ca/rss/city/on-82_e.xml", "latitude": 43.451, "longitude": -80.488, "site_province_code": "35"}
+not json
+
+{"name": "Waterloo", "url": "https://weather.gc.ca/rss/city/on-83_e.xml"
+{"name": "Creston", "url": "https://weather.gc.ca/rss/city/bc-26_e.xml", "latitude": 49.1, "longitude": -116.5, "site_province_code": "59"}
+`), 0o644)
+	assert.NoError(t, err)
+
+	viper.Set("envcan_time_zones", []map[string]interface{}{
+		{"name": "Creston", "time_zone": "America/Creston"},
+	})
+
+	core, logs := observer.New(zapcore.WarnLevel)
+	entries, err := loadEnvCanMap(zap.New(core), path)
+	assert.NoError(t, err)
+
+	// Invalid lines are skipped, and reported with their line number; blank lines aren't counted as invalid.
+	var lines []int64
+	for _, entry := range logs.All() {
+		lines = append(lines, entry.ContextMap()["line"].(int64))
+	}
+	assert.Equal(t, []int64{2, 4}, lines)
+
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, path+":1", entries[0].source)
+		assert.Equal(t, "envcan", entries[0].config.String("type"))
+		assert.Empty(t, entries[0].config.String("time_zone"))
+
+		assert.Equal(t, path+":5", entries[1].source)
+		assert.Equal(t, "America/Creston", entries[1].config.String("time_zone"))
+	}
+}
+
+func TestLoadStationConfigs(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	path := filepath.Join(t.TempDir(), "weatherd.yaml")
+	err := os.WriteFile(path, []byte(`stations:
+  - type: noaa
+    name: San Francisco
+    latitude: 37.7749
+    longitude: -122.4194
+  - type: envcan
+    name: Kitchener Waterloo
+`), 0o644)
+	assert.NoError(t, err)
+
+	viper.SetConfigFile(path)
+	assert.NoError(t, viper.ReadInConfig())
+
+	entries := loadStationConfigs(zap.NewNop())
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, path+": stations[0]", entries[0].source)
+		assert.Equal(t, "San Francisco", entries[0].config.String("name"))
+		assert.Equal(t, path+": stations[1]", entries[1].source)
+	}
+}
+
+func TestNewStations(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	entries := []stationEntry{
+		{
+			source: "stations[0]",
+			config: weather.StationConfig{
+				"type":      "envcan",
+				"name":      "Kitchener Waterloo",
+				"url":       "https://weather.gc.ca/rss/city/on-82_e.xml",
+				"latitude":  43.451,
+				"longitude": -80.488,
+			},
+		},
+		{
+			source: "stations[1]",
+			config: weather.StationConfig{
+				"type": "unknown",
+				"name": "Nowhere",
+			},
+		},
+		{
+			source: "stations[2]",
+			config: weather.StationConfig{
+				"type": "envcan",
+				"name": "Waterloo",
+			},
+		},
+		{
+			source: "stations[3]",
+			config: weather.StationConfig{
+				"type":      "noaa",
+				"name":      "San Francisco",
+				"latitude":  37.7749,
+				"longitude": -122.4194,
+			},
+		},
+	}
+
+	core, logs := observer.New(zapcore.WarnLevel)
+	stations := newStations(zap.New(core), entries, nil)
+
+	var names []string
+	for _, station := range stations {
+		names = append(names, station.Name())
+	}
+	assert.Equal(t, []string{"Kitchener Waterloo", "San Francisco"}, names)
+
+	// Entries with an unknown type or invalid configuration are skipped, and reported with their source.
+	var sources []string
+	for _, entry := range logs.FilterMessage("skipping invalid station").All() {
+		sources = append(sources, entry.ContextMap()["source"].(string))
+	}
+	assert.Equal(t, []string{"stations[1]", "stations[2]"}, sources)
+}
+
+func TestHTTPConfig(t *testing.T) {
+	defaults := weather.HTTPConfig{
+		UserAgent: "default-agent",
+		Accept:    "application/geo+json",
+		Timeout:   time.Second * 10,
+		Headers: map[string]string{
+			"X-Default": "default",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		settings map[string]interface{}
+		config   weather.HTTPConfig
+	}{
+		{
+			name:   "defaults",
+			config: defaults,
+		},
+		{
+			name: "shared",
+			settings: map[string]interface{}{
+				"http.user_agent":   "shared-agent",
+				"http.timeout":      "20s",
+				"http.max_attempts": 5,
+				"http.headers":      map[string]string{"X-Shared": "shared"},
+			},
+			config: weather.HTTPConfig{
+				UserAgent:   "shared-agent",
+				Accept:      "application/geo+json",
+				Timeout:     time.Second * 20,
+				MaxAttempts: 5,
+				Headers: map[string]string{
+					"X-Default": "default",
+					"X-Shared":  "shared",
+				},
+			},
+		},
+		{
+			name: "provider",
+			settings: map[string]interface{}{
+				"http.user_agent":      "shared-agent",
+				"http.timeout":         "20s",
+				"http.headers":         map[string]string{"X-Shared": "shared"},
+				"noaa.http.user_agent": "noaa-agent",
+				"noaa.http.accept":     "application/ld+json",
+				"noaa.http.headers":    map[string]string{"X-Shared": "noaa"},
+			},
+			config: weather.HTTPConfig{
+				UserAgent: "noaa-agent",
+				Accept:    "application/ld+json",
+				Timeout:   time.Second * 20,
+				Headers: map[string]string{
+					"X-Default": "default",
+					"X-Shared":  "noaa",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+			for key, value := range tt.settings {
+				viper.Set(key, value)
+			}
+
+			assert.Equal(t, tt.config, httpConfig("noaa", defaults))
+		})
+	}
+}
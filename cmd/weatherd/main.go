@@ -1,32 +1,143 @@
 package main
 
 import (
+	"context"
+	_ "expvar"
+	"flag"
 	"fmt"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+	// Station time zones are loaded by name, which shouldn't depend on the zone database of the host.
+	_ "time/tzdata"
 
 	"github.com/rmrobinson/weather"
-	"github.com/rmrobinson/weather/envcan"
-	"github.com/rmrobinson/weather/noaa"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
 
 func main() {
+	var (
+		configPath = flag.String("config", "", "The path to the config file listing the stations to serve")
+	)
+	flag.Parse()
+
 	viper.SetEnvPrefix("NVS")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.AutomaticEnv()
 	viper.BindEnv("ENVCAN_MAP")
 
+	// api.weather.gov asks that the user agent identify the application and a contact, set with NVS_HTTP_USER_AGENT.
+	viper.SetDefault("http.user_agent", weather.DefaultUserAgent)
+	viper.SetDefault("http.timeout", time.Second*30)
+	viper.SetDefault("refresh.interval", time.Minute*30)
+	viper.SetDefault("refresh.min_interval", time.Minute*5)
+	viper.SetDefault("refresh.jitter", time.Minute*5)
+	viper.SetDefault("refresh.concurrency", 4)
+	viper.SetDefault("max_age", time.Hour*3)
+	viper.SetDefault("max_distance_km", 150)
+	viper.SetDefault("fallback_stations", 3)
+	viper.SetDefault("interpolation_stations", 4)
+	viper.SetDefault("history.retention", time.Hour*24*90)
+
 	logger, err := zap.NewDevelopment()
 	if err != nil {
 		panic(err)
 	}
 
+	if len(*configPath) > 0 {
+		viper.SetConfigFile(*configPath)
+		err = viper.ReadInConfig()
+		if err != nil {
+			logger.Fatal("unable to read config file",
+				zap.String("path", *configPath),
+				zap.Error(err),
+			)
+		}
+	}
+
+	entries := loadStationConfigs(logger)
+	if envcanMapPath := viper.GetString("ENVCAN_MAP"); len(envcanMapPath) > 0 {
+		envcanEntries, err := loadEnvCanMap(logger, envcanMapPath)
+		if err != nil {
+			logger.Fatal("unable to read envcan map",
+				zap.String("path", envcanMapPath),
+				zap.Error(err),
+			)
+		}
+		entries = append(entries, envcanEntries...)
+	}
+
+	// Offline mode can be toggled at runtime with SIGUSR1, or the SetOfflineMode RPC of the admin listener.
+	offline := weather.NewOfflineSwitch(logger, viper.GetBool("offline"))
+
 	api := weather.NewAPI(logger)
+	api.SetMaxAge(viper.GetDuration("max_age"))
+	api.SetMaxDistance(viper.GetFloat64("max_distance_km"))
+	api.SetFallbackStations(viper.GetInt("fallback_stations"))
+	api.SetInterpolationStations(viper.GetInt("interpolation_stations"))
+	api.SetOfflineSwitch(offline)
+	scheduler := weather.NewScheduler(logger,
+		viper.GetDuration("refresh.interval"),
+		viper.GetDuration("refresh.jitter"),
+		viper.GetInt("refresh.concurrency"),
+	)
+	scheduler.SetMinInterval(viper.GetDuration("refresh.min_interval"))
+	scheduler.SetOfflineSwitch(offline)
+	api.SetScheduler(scheduler)
+
+	stations := newStations(logger, entries, offline)
+	for _, station := range stations {
+		api.RegisterStation(station)
+	}
+
+	if len(stations) < 1 {
+		logger.Warn("no stations configured")
+	}
+
+	// Restoring the data saved before the last shutdown means requests can be answered before stations are refreshed.
+	if snapshotDir := viper.GetString("snapshot_dir"); len(snapshotDir) > 0 {
+		store, err := weather.NewSnapshotStore(logger, snapshotDir)
+		if err != nil {
+			logger.Fatal("unable to open snapshot dir",
+				zap.String("path", snapshotDir),
+				zap.Error(err),
+			)
+		}
+
+		// When starting offline the snapshots are the only data available, so they are used regardless of age.
+		maxAge := viper.GetDuration("max_age")
+		if offline.Offline() {
+			maxAge = 0
+		}
+
+		restored := store.Restore(stations, maxAge)
+		logger.Info("restored station snapshots",
+			zap.Int("count", restored),
+		)
+		scheduler.SetSnapshotStore(store)
+	}
+	if historyDir := viper.GetString("history.dir"); len(historyDir) > 0 {
+		history, err := weather.NewHistoryStore(logger, historyDir, viper.GetDuration("history.retention"))
+		if err != nil {
+			logger.Fatal("unable to open history dir",
+				zap.String("path", historyDir),
+				zap.Error(err),
+			)
+		}
+
+		api.SetHistoryStore(history)
+		scheduler.SetHistoryStore(history)
+	}
 
-	kwStation := envcan.NewStation(logger, "https://weather.gc.ca/rss/weather/43.451_-80.488_e.xml", "Kitchener Waterloo", 43.451, -80.488)
-	api.RegisterStation(kwStation)
-	sfStation := noaa.NewStation(logger, "https://api.weather.gov/gridpoints/MTR/88,126", "San Francisco", 37.7749, -122.4194)
-	api.RegisterStation(sfStation)
+	logger.Info("registered stations",
+		zap.Int("count", len(stations)),
+	)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", 10101))
 	if err != nil {
@@ -35,12 +146,79 @@ func main() {
 		)
 	}
 
+	// The upstream request counts and circuit breaker states are published at /debug/vars.
+	if metricsAddr := viper.GetString("metrics.addr"); len(metricsAddr) > 0 {
+		go func() {
+			err := http.ListenAndServe(metricsAddr, nil)
+			if err != nil {
+				logger.Warn("failed to serve metrics",
+					zap.String("addr", metricsAddr),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	// Streams are ended on shutdown, as otherwise the server waits on them until every client goes away.
+	api.SetContext(ctx)
+
+	toggleOffline := make(chan os.Signal, 1)
+	signal.Notify(toggleOffline, syscall.SIGUSR1)
+	go func() {
+		for range toggleOffline {
+			offline.Set(!offline.Offline())
+		}
+	}()
+
+	schedulerDone := make(chan struct{})
+	go func() {
+		scheduler.Run(ctx)
+		close(schedulerDone)
+	}()
+
 	grpcServer := grpc.NewServer()
 	weather.RegisterWeatherServiceServer(grpcServer, api)
+
+	// The AdminService isn't served to the clients of the WeatherService, as it changes the operation of every station.
+	var adminServer *grpc.Server
+	if adminAddr := viper.GetString("admin.addr"); len(adminAddr) > 0 {
+		adminLis, err := listenAdmin(adminAddr)
+		if err != nil {
+			logger.Fatal("failed to listen for admin requests",
+				zap.String("addr", adminAddr),
+				zap.Error(err),
+			)
+		}
+
+		adminServer = grpc.NewServer()
+		weather.RegisterAdminServiceServer(adminServer, weather.NewAdminAPI(logger, offline))
+		go func() {
+			err := adminServer.Serve(adminLis)
+			if err != nil {
+				logger.Warn("failed to serve admin requests",
+					zap.String("addr", adminAddr),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+
+	go func() {
+		<-ctx.Done()
+		logger.Info("shutting down")
+		if adminServer != nil {
+			adminServer.GracefulStop()
+		}
+		grpcServer.GracefulStop()
+	}()
+
 	err = grpcServer.Serve(lis)
 	if err != nil {
 		logger.Fatal("failed to serve",
 			zap.Error(err),
 		)
 	}
+	<-schedulerDone
 }
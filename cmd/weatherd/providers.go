@@ -0,0 +1,7 @@
+package main
+
+// The providers which stations can be configured with. Additional providers register themselves when imported here.
+import (
+	_ "github.com/rmrobinson/weather/envcan"
+	_ "github.com/rmrobinson/weather/noaa"
+)
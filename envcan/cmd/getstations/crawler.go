@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/mmcdole/gofeed"
+	"github.com/rmrobinson/weather"
 	"go.uber.org/zap"
 )
 
@@ -25,6 +26,7 @@ type weatherStation struct {
 
 type crawler struct {
 	logger *zap.Logger
+	client *weather.HTTPClient
 }
 
 func (c *crawler) getWeatherStations(ctx context.Context) []weatherStation {
@@ -53,7 +55,7 @@ func (c *crawler) getWeatherStations(ctx context.Context) []weatherStation {
 }
 
 func (c *crawler) loadPath(ctx context.Context, path string) (*weatherStation, error) {
-	req, err := http.NewRequest(http.MethodGet, path, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		c.logger.Warn("error creating new request",
 			zap.Error(err),
@@ -61,10 +63,7 @@ func (c *crawler) loadPath(ctx context.Context, path string) (*weatherStation, e
 		return nil, err
 	}
 
-	req.WithContext(ctx)
-
-	client := http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.client.Do(req)
 	if err != nil {
 		c.logger.Warn("error performing request",
 			zap.Error(err),
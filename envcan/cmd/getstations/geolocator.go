@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/rmrobinson/weather"
 	"go.uber.org/zap"
 )
 
@@ -28,10 +29,11 @@ type geogratisResponse struct {
 
 type geogratisAPI struct {
 	logger *zap.Logger
+	client *weather.HTTPClient
 }
 
 func (api *geogratisAPI) geocode(ctx context.Context, name string, provinceCode string) (*geogratisItem, error) {
-	req, err := http.NewRequest(http.MethodGet, "http://geogratis.gc.ca/services/geoname/en/geonames.json", nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://geogratis.gc.ca/services/geoname/en/geonames.json", nil)
 	if err != nil {
 		api.logger.Warn("error creating new request",
 			zap.Error(err),
@@ -43,10 +45,8 @@ func (api *geogratisAPI) geocode(ctx context.Context, name string, provinceCode
 	q.Add("q", name)
 
 	req.URL.RawQuery = q.Encode()
-	req.WithContext(ctx)
 
-	client := http.Client{}
-	resp, err := client.Do(req)
+	resp, err := api.client.Do(req)
 	if err != nil {
 		api.logger.Warn("error performing request",
 			zap.Error(err),
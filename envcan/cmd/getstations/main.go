@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"flag"
 	"os"
+	"time"
 
+	"github.com/rmrobinson/weather"
 	"go.uber.org/zap"
 )
 
@@ -39,6 +41,8 @@ type weatherInfo struct {
 func main() {
 	var (
 		outputPath = flag.String("output", "/tmp/weather.json", "The path to save the results to")
+		userAgent  = flag.String("user-agent", weather.DefaultUserAgent, "The user agent to identify requests with; ideally including a contact")
+		timeout    = flag.Duration("timeout", time.Second*30, "The maximum time to wait for each request")
 	)
 	flag.Parse()
 
@@ -55,11 +59,18 @@ func main() {
 		return
 	}
 
+	client := weather.NewHTTPClient(logger, weather.HTTPConfig{
+		UserAgent: *userAgent,
+		Timeout:   *timeout,
+	})
+
 	c := crawler{
 		logger: logger,
+		client: client,
 	}
 	geoAPI := &geogratisAPI{
 		logger: logger,
+		client: client,
 	}
 
 	stations := c.getWeatherStations(context.Background())
@@ -0,0 +1,54 @@
+package envcan
+
+import (
+	"fmt"
+
+	"github.com/rmrobinson/weather"
+	"go.uber.org/zap"
+)
+
+// ProviderName is the name Environment Canada stations are registered with.
+const ProviderName = "envcan"
+
+func init() {
+	weather.RegisterProvider(ProviderName, weather.Provider{
+		NewStation: newStationFromConfig,
+	})
+}
+
+// newStationFromConfig creates a station from the "name", "url", "latitude" and "longitude" config values.
+// These match the records written by envcan/cmd/getstations. The time zone is set by the optional "time_zone"
+// value, or otherwise derived from the "site_province_code" value written by getstations.
+func newStationFromConfig(logger *zap.Logger, client *weather.HTTPClient, config weather.StationConfig) (weather.Station, error) {
+	name := config.String("name")
+	if len(name) < 1 {
+		return nil, fmt.Errorf("%w: name", weather.ErrMissingConfig)
+	}
+
+	url := config.String("url")
+	if len(url) < 1 {
+		return nil, fmt.Errorf("%w: url", weather.ErrMissingConfig)
+	}
+
+	lat, lon, err := config.Coordinates()
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := config.TimeZone()
+	if err != nil {
+		return nil, err
+	} else if loc == nil {
+		loc = provinceTimeZone(config.String("site_province_code"), lon)
+	}
+	if loc == nil {
+		logger.Warn("station has no time zone, approximating it from the longitude",
+			zap.String("name", name),
+			zap.String("site_province_code", config.String("site_province_code")),
+		)
+	}
+
+	s := NewStation(logger, client, url, name, lat, lon)
+	s.SetTimeZone(loc)
+	return s, nil
+}
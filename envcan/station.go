@@ -3,6 +3,7 @@ package envcan
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -16,32 +17,41 @@ import (
 
 var (
 	// ErrInvalidDate is returned if an invalid date qualifier is supplied.
-	ErrInvalidDate   = errors.New("invalid date supplied")
-	refreshFrequency = time.Minute * 30
+	ErrInvalidDate = errors.New("invalid date supplied")
 )
 
 // Station contains the data about a single weather location reported on by Environment Canada
 type Station struct {
+	*weather.StationCache
+
 	url       string
 	title     string
 	latitude  float64
 	longitude float64
 
 	logger *zap.Logger
+	client *weather.HTTPClient
 
-	currentReport *weather.WeatherReport
-	forecast      []*weather.WeatherForecast
-	lastRefreshed time.Time
+	timeZone *time.Location
 }
 
-func NewStation(logger *zap.Logger, url string, title string, lat float64, lon float64) *Station {
-	return &Station{
+// NewStation creates a new station which retrieves its feed from the supplied URL.
+// If client is nil, requests are made using the default HTTP configuration.
+func NewStation(logger *zap.Logger, client *weather.HTTPClient, url string, title string, lat float64, lon float64) *Station {
+	if client == nil {
+		client = weather.NewHTTPClient(logger, weather.HTTPConfig{})
+	}
+
+	s := &Station{
 		url:       url,
 		title:     title,
 		latitude:  lat,
 		longitude: lon,
 		logger:    logger,
+		client:    client,
 	}
+	s.StationCache = weather.NewStationCache(s.fetch)
+	return s
 }
 
 // Name returns the printable name of this weather station
@@ -59,81 +69,64 @@ func (s *Station) Longitude() float64 {
 	return s.longitude
 }
 
-// GetReport returns the current weather report for this station.
-func (s *Station) GetReport(ctx context.Context) (*weather.WeatherReport, error) {
-	if s.shouldRefresh() {
-		err := s.refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return s.currentReport, nil
-}
-
-// GetForecast returns the forecast for this station
-func (s *Station) GetForecast(ctx context.Context) ([]*weather.WeatherForecast, error) {
-	if s.shouldRefresh() {
-		err := s.refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return s.forecast, nil
+// SetTimeZone sets the time zone of this weather station, as the feed doesn't include it.
+// It must be called before the station is used.
+func (s *Station) SetTimeZone(loc *time.Location) {
+	s.timeZone = loc
 }
 
-func (s *Station) shouldRefresh() bool {
-	return time.Now().Add(refreshFrequency * -1).After(s.lastRefreshed)
+// TimeZone returns the time zone of this weather station, or nil if it hasn't been set.
+func (s *Station) TimeZone() *time.Location {
+	return s.timeZone
 }
 
-func (s *Station) refresh(ctx context.Context) error {
-	feed, err := s.getFeed(ctx)
+// fetch retrieves the latest report, forecast and alerts for this station from its feed.
+func (s *Station) fetch(ctx context.Context, previous *weather.StationData) (*weather.StationData, error) {
+	feed, expires, err := s.getFeed(ctx)
 	if err != nil {
 		s.logger.Warn("error getting feed",
 			zap.Error(err),
 		)
-		return err
-	} else if feed == nil {
-		s.logger.Info("no feed from station to refresh, ignoring", zap.String("station_title", s.title))
-		return nil
+		return nil, err
 	}
 
-	report, forecast, err := s.parseFeed(feed)
+	report, forecast, alerts, err := s.parseFeed(feed)
 	if err != nil {
 		s.logger.Warn("error parsing feed",
 			zap.Error(err),
 		)
-		return err
+		return nil, err
 	}
 
-	s.currentReport = report
-	s.forecast = forecast
-	s.lastRefreshed = time.Now()
-
 	s.logger.Debug("refreshed station",
 		zap.String("station_title", s.title),
 	)
 
-	return nil
+	return &weather.StationData{
+		Report:      report,
+		Forecast:    forecast,
+		Alerts:      alerts,
+		RefreshedAt: time.Now(),
+		Expires:     expires,
+	}, nil
 }
 
-func (s *Station) getFeed(ctx context.Context) (*gofeed.Feed, error) {
+// getFeed retrieves the feed of this station, along with when the feed will be out of date if it is known.
+func (s *Station) getFeed(ctx context.Context) (*gofeed.Feed, time.Time, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
 	if err != nil {
 		s.logger.Warn("error creating new request",
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, time.Time{}, err
 	}
 
-	client := http.Client{}
-	resp, err := client.Do(req)
+	resp, err := s.client.Do(req)
 	if err != nil {
 		s.logger.Warn("error performing request",
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, time.Time{}, err
 	}
 	defer resp.Body.Close()
 
@@ -141,7 +134,7 @@ func (s *Station) getFeed(ctx context.Context) (*gofeed.Feed, error) {
 		s.logger.Info("received non-OK response",
 			zap.Int("status_code", resp.StatusCode),
 		)
-		return nil, nil
+		return nil, time.Time{}, fmt.Errorf("%w %d", weather.ErrUnexpectedStatusCode, resp.StatusCode)
 	}
 
 	fp := gofeed.NewParser()
@@ -150,17 +143,18 @@ func (s *Station) getFeed(ctx context.Context) (*gofeed.Feed, error) {
 		s.logger.Warn("error parsing feed",
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, time.Time{}, err
 	}
 
-	return feed, nil
+	return feed, weather.ResponseExpires(resp, time.Now()), nil
 }
 
-func (s *Station) parseFeed(feed *gofeed.Feed) (*weather.WeatherReport, []*weather.WeatherForecast, error) {
+func (s *Station) parseFeed(feed *gofeed.Feed) (*weather.WeatherReport, []*weather.WeatherForecast, []*weather.WeatherAlert, error) {
 	report := &weather.WeatherReport{
 		Conditions: &weather.WeatherCondition{},
 	}
 	var forecasts []*weather.WeatherForecast
+	var alerts []*weather.WeatherAlert
 
 	for _, item := range feed.Items {
 		for _, category := range item.Categories {
@@ -195,11 +189,70 @@ func (s *Station) parseFeed(feed *gofeed.Feed) (*weather.WeatherReport, []*weath
 				}
 
 				forecasts = append(forecasts, forecast)
+			} else if category == "Warnings and Watches" {
+				alert := warningToAlert(item)
+				if alert != nil {
+					alerts = append(alerts, alert)
+				}
 			}
 		}
 	}
 
-	return report, forecasts, nil
+	return report, forecasts, alerts, nil
+}
+
+// warningToAlert converts a feed item from the warnings category into an alert.
+// Nil is returned if the item indicates that there is nothing in effect.
+func warningToAlert(item *gofeed.Item) *weather.WeatherAlert {
+	title := strings.TrimSpace(item.Title)
+	lowerTitle := strings.ToLower(title)
+	if strings.HasPrefix(lowerTitle, "no watches or warnings") || strings.Contains(lowerTitle, " ended") {
+		return nil
+	}
+
+	alert := &weather.WeatherAlert{
+		AlertId:     item.GUID,
+		Severity:    severityFromFeedText(title),
+		Headline:    title,
+		Description: strings.TrimSpace(item.Description),
+	}
+
+	// Titles are of the form "YELLOW WARNING - FREEZING RAIN, Kitchener-Waterloo"
+	if idx := strings.LastIndex(title, ","); idx >= 0 {
+		alert.Headline = strings.TrimSpace(title[:idx])
+		alert.Area = strings.TrimSpace(title[idx+1:])
+	}
+
+	if item.PublishedParsed != nil {
+		alert.IssuedAt = timestamppb.New(*item.PublishedParsed)
+	} else if item.UpdatedParsed != nil {
+		alert.IssuedAt = timestamppb.New(*item.UpdatedParsed)
+	}
+
+	return alert
+}
+
+func severityFromFeedText(text string) weather.AlertSeverity {
+	text = strings.ToLower(text)
+
+	// Environment Canada colour codes its alerts by the expected impact.
+	if strings.HasPrefix(text, "red") {
+		return weather.AlertSeverity_EXTREME
+	} else if strings.HasPrefix(text, "orange") {
+		return weather.AlertSeverity_SEVERE
+	} else if strings.HasPrefix(text, "yellow") {
+		return weather.AlertSeverity_MODERATE
+	}
+
+	if strings.Contains(text, "warning") {
+		return weather.AlertSeverity_SEVERE
+	} else if strings.Contains(text, "watch") {
+		return weather.AlertSeverity_MODERATE
+	} else if strings.Contains(text, "statement") || strings.Contains(text, "advisory") {
+		return weather.AlertSeverity_MINOR
+	}
+
+	return weather.AlertSeverity_UNKNOWN_SEVERITY
 }
 
 func currentConditionsToCondition(cc string) *weather.WeatherCondition {
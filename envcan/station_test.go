@@ -1,11 +1,17 @@
 package envcan
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/mmcdole/gofeed"
 	"github.com/rmrobinson/weather"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type currentConditionToConditionTest struct {
@@ -191,3 +197,86 @@ func TestFloatFromFeedText(t *testing.T) {
 		})
 	}
 }
+
+type warningToAlertTest struct {
+	name   string
+	item   *gofeed.Item
+	result *weather.WeatherAlert
+}
+
+var warningIssued = time.Date(2024, time.January, 12, 9, 57, 0, 0, time.UTC)
+
+var warningToAlertTests = []warningToAlertTest{
+	{
+		"no warnings",
+		&gofeed.Item{
+			Title:       "No watches or warnings in effect, Kitchener-Waterloo",
+			Description: "No watches or warnings in effect.",
+		},
+		nil,
+	},
+	{
+		"colour coded warning",
+		&gofeed.Item{
+			GUID:            "tag:weather.gc.ca,2013-04-16:20240112095700",
+			Title:           "YELLOW WARNING - FREEZING RAIN, Kitchener-Waterloo",
+			Description:     "Periods of freezing rain are expected tonight. Issued: 4:57 AM EST Friday 12 January 2024 ",
+			PublishedParsed: &warningIssued,
+		},
+		&weather.WeatherAlert{
+			AlertId:     "tag:weather.gc.ca,2013-04-16:20240112095700",
+			Severity:    weather.AlertSeverity_MODERATE,
+			Headline:    "YELLOW WARNING - FREEZING RAIN",
+			Description: "Periods of freezing rain are expected tonight. Issued: 4:57 AM EST Friday 12 January 2024",
+			Area:        "Kitchener-Waterloo",
+			IssuedAt:    timestamppb.New(warningIssued),
+		},
+	},
+	{
+		"legacy warning",
+		&gofeed.Item{
+			GUID:            "tag:weather.gc.ca,2013-04-16:20190105110000",
+			Title:           "WIND WARNING IN EFFECT, Kitchener-Waterloo",
+			Description:     "Strong winds are expected.",
+			PublishedParsed: &warningIssued,
+		},
+		&weather.WeatherAlert{
+			AlertId:     "tag:weather.gc.ca,2013-04-16:20190105110000",
+			Severity:    weather.AlertSeverity_SEVERE,
+			Headline:    "WIND WARNING IN EFFECT",
+			Description: "Strong winds are expected.",
+			Area:        "Kitchener-Waterloo",
+			IssuedAt:    timestamppb.New(warningIssued),
+		},
+	},
+	{
+		"ended statement",
+		&gofeed.Item{
+			Title: "SPECIAL WEATHER STATEMENT ENDED, Kitchener-Waterloo",
+		},
+		nil,
+	},
+}
+
+func TestWarningToAlert(t *testing.T) {
+	for _, tt := range warningToAlertTests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := warningToAlert(tt.item)
+			assert.Equal(t, tt.result, res)
+		})
+	}
+}
+
+func TestStation_RefreshUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := weather.NewHTTPClient(zap.NewNop(), weather.HTTPConfig{})
+	s := NewStation(zap.NewNop(), client, server.URL, "Kitchener-Waterloo", 43.451, -80.488)
+
+	err := s.Refresh(context.Background())
+	assert.ErrorIs(t, err, weather.ErrUnexpectedStatusCode)
+	assert.True(t, s.Stale())
+}
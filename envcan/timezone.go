@@ -0,0 +1,84 @@
+package envcan
+
+import (
+	"strings"
+	"time"
+)
+
+// provinceZone is the time zone observed across a province or territory, or most of it.
+type provinceZone struct {
+	zone string
+	// splits divide provinces which span several time zones by longitude, from west to east.
+	// Each applies to the stations east of its longitude, until the next.
+	splits []timeZoneSplit
+}
+
+type timeZoneSplit struct {
+	longitude float64
+	zone      string
+}
+
+// provinceTimeZones maps the Standard Geographical Classification codes of each province and territory,
+// as written to site_province_code by envcan/cmd/getstations, to its time zone.
+// Places which don't follow the rest of their province, such as the parts of British Columbia on mountain time,
+// need the time zone of their station to be set explicitly.
+var provinceTimeZones = map[string]provinceZone{
+	"10": {zone: "America/St_Johns"},
+	"11": {zone: "America/Halifax"},
+	"12": {zone: "America/Halifax"},
+	"13": {zone: "America/Moncton"},
+	"24": {zone: "America/Toronto"},
+	"35": {zone: "America/Winnipeg", splits: []timeZoneSplit{{-90, "America/Toronto"}}},
+	"46": {zone: "America/Winnipeg"},
+	"47": {zone: "America/Regina"},
+	"48": {zone: "America/Edmonton"},
+	"59": {zone: "America/Vancouver"},
+	"60": {zone: "America/Whitehorse"},
+	"61": {zone: "America/Edmonton"},
+	"62": {zone: "America/Cambridge_Bay", splits: []timeZoneSplit{{-102, "America/Rankin_Inlet"}, {-85, "America/Iqaluit"}}},
+}
+
+// provinceCodes maps the postal abbreviations of each province and territory onto their classification codes.
+var provinceCodes = map[string]string{
+	"nl": "10",
+	"pe": "11",
+	"ns": "12",
+	"nb": "13",
+	"qc": "24",
+	"on": "35",
+	"mb": "46",
+	"sk": "47",
+	"ab": "48",
+	"bc": "59",
+	"yt": "60",
+	"nt": "61",
+	"nu": "62",
+}
+
+// provinceTimeZone returns the time zone of the station at the supplied longitude in the province with the supplied
+// classification code (i.e. "35") or postal abbreviation (i.e. "ON").
+// Nil is returned if the province isn't known.
+func provinceTimeZone(province string, longitude float64) *time.Location {
+	code := strings.ToLower(strings.TrimSpace(province))
+	if abbreviated, ok := provinceCodes[code]; ok {
+		code = abbreviated
+	}
+
+	ptz, ok := provinceTimeZones[code]
+	if !ok {
+		return nil
+	}
+
+	zone := ptz.zone
+	for _, split := range ptz.splits {
+		if longitude > split.longitude {
+			zone = split.zone
+		}
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil
+	}
+	return loc
+}
@@ -0,0 +1,61 @@
+package envcan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rmrobinson/weather"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestProvinceTimeZone(t *testing.T) {
+	tests := []struct {
+		name      string
+		province  string
+		longitude float64
+		zone      string
+	}{
+		{"classification code", "35", -80.488, "America/Toronto"},
+		{"postal abbreviation", "ON", -80.488, "America/Toronto"},
+		{"half hour offset", "10", -52.712, "America/St_Johns"},
+		{"northwestern ontario", "35", -94.5, "America/Winnipeg"},
+		{"eastern nunavut", "62", -68.517, "America/Iqaluit"},
+		{"central nunavut", "62", -92.083, "America/Rankin_Inlet"},
+		{"western nunavut", "62", -105.05, "America/Cambridge_Bay"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := provinceTimeZone(tt.province, tt.longitude)
+			if assert.NotNil(t, loc) {
+				assert.Equal(t, tt.zone, loc.String())
+			}
+		})
+	}
+
+	assert.Nil(t, provinceTimeZone("", -80.488))
+	assert.Nil(t, provinceTimeZone("99", -80.488))
+}
+
+func TestNewStationFromConfig_TimeZone(t *testing.T) {
+	config := weather.StationConfig{
+		"name":               "Kitchener-Waterloo",
+		"url":                "https://weather.gc.ca/rss/city/on-82_e.xml",
+		"latitude":           43.451,
+		"longitude":          -80.488,
+		"site_province_code": "35",
+	}
+
+	// Daylight saving time is observed, unlike the approximation from the longitude.
+	station, err := newStationFromConfig(zap.NewNop(), nil, config)
+	assert.NoError(t, err)
+	_, offset := time.Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC).In(weather.StationTimeZone(station)).Zone()
+	assert.Equal(t, -4*60*60, offset)
+
+	// The time zone of the station takes precedence over that of its province.
+	config["time_zone"] = "America/Winnipeg"
+	station, err = newStationFromConfig(zap.NewNop(), nil, config)
+	assert.NoError(t, err)
+	assert.Equal(t, "America/Winnipeg", weather.StationTimeZone(station).String())
+}
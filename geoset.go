@@ -2,45 +2,180 @@ package weather
 
 import (
 	"math"
+	"sort"
+	"sync"
 )
 
-type entry struct {
+// earthRadius is the mean radius of the Earth in metres, via https://en.wikipedia.org/wiki/Great-circle_distance
+const earthRadius = float64(6371000)
+
+type entry[T any] struct {
+	key       string
 	latitude  float64
 	longitude float64
 
-	value interface{}
+	value T
+}
+
+// GeoResult is an entry of a GeoSet found by a lookup.
+type GeoResult[T any] struct {
+	Key       string
+	Latitude  float64
+	Longitude float64
+	Value     T
+	// Distance is the great-circle distance from the location looked up, in metres.
+	Distance float64
 }
 
 // GeoSet is a collection that allows for values to be stored by their latitude and longitude;
-// and allows for lookups to find the entry closest to the supplied latitude and longitude.
-type GeoSet struct {
-	entries []entry
+// and allows for lookups to find the entries closest to the supplied latitude and longitude.
+// Entries are indexed by a k-d tree, so lookups don't need to compare against every entry.
+// Each entry has a unique key, by which it can be updated or removed.
+// It is safe for concurrent use; lookups only block while the set is being changed.
+type GeoSet[T any] struct {
+	mu    sync.RWMutex
+	tree  kdTree[T]
+	nodes map[string]*kdNode[T]
 }
 
 // NewGeoSet returns a new GeoSet
-func NewGeoSet() *GeoSet {
-	return &GeoSet{}
+func NewGeoSet[T any]() *GeoSet[T] {
+	return &GeoSet[T]{
+		nodes: map[string]*kdNode[T]{},
+	}
 }
 
-// Add the supplied value to the location specified with the latitude and longitude (in degrees)
-func (gs *GeoSet) Add(lat float64, lon float64, value interface{}) {
-	gs.entries = append(gs.entries, entry{lat, lon, value})
+// Add the supplied value to the location specified with the latitude and longitude (in degrees).
+// Any entry already added with the same key is replaced.
+func (gs *GeoSet[T]) Add(key string, lat float64, lon float64, value T) {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	gs.set(entry[T]{key, lat, lon, value})
 }
 
-// Closest returns the entry in the set that is nearest to the supplied latitude and longitude (in degrees)
-func (gs *GeoSet) Closest(lat float64, lon float64) interface{} {
-	shortestDistance := math.MaxFloat64
-	var value interface{}
+// Update replaces the location and value of the entry with the supplied key.
+// False is returned, and nothing is added, if there isn't an entry with the key.
+func (gs *GeoSet[T]) Update(key string, lat float64, lon float64, value T) bool {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	if _, ok := gs.nodes[key]; !ok {
+		return false
+	}
+	gs.set(entry[T]{key, lat, lon, value})
+	return true
+}
 
-	for _, entry := range gs.entries {
-		distance := distance(lat, lon, entry.latitude, entry.longitude)
-		if distance < shortestDistance {
-			shortestDistance = distance
-			value = entry.value
+func (gs *GeoSet[T]) set(e entry[T]) {
+	if node, ok := gs.nodes[e.key]; ok {
+		// The node can be reused unless it needs to move to a different part of the tree.
+		if node.entry.latitude == e.latitude && node.entry.longitude == e.longitude {
+			node.entry = e
+			return
 		}
+		gs.tree.remove(node)
 	}
 
-	return value
+	gs.nodes[e.key] = gs.tree.insert(e)
+}
+
+// Remove the entry with the supplied key. False is returned if there isn't one.
+func (gs *GeoSet[T]) Remove(key string) bool {
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+
+	node, ok := gs.nodes[key]
+	if !ok {
+		return false
+	}
+
+	delete(gs.nodes, key)
+	gs.tree.remove(node)
+	return true
+}
+
+// Len returns the number of entries in the set.
+func (gs *GeoSet[T]) Len() int {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	return len(gs.nodes)
+}
+
+// Closest returns the value in the set that is nearest to the supplied latitude and longitude (in degrees),
+// along with its distance in metres. False is returned if the set is empty.
+func (gs *GeoSet[T]) Closest(lat float64, lon float64) (T, float64, bool) {
+	results := gs.KNearest(lat, lon, 1)
+	if len(results) < 1 {
+		var none T
+		return none, 0, false
+	}
+	return results[0].Value, results[0].Distance, true
+}
+
+// KNearest returns the k entries in the set that are nearest to the supplied latitude and longitude (in degrees),
+// closest first. Fewer are returned if the set doesn't contain k entries.
+func (gs *GeoSet[T]) KNearest(lat float64, lon float64, k int) []GeoResult[T] {
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	return geoResults(lat, lon, gs.tree.nearest(toUnitVector(lat, lon), k))
+}
+
+// Within returns the entries in the set that are within radiusMeters of the supplied latitude and longitude
+// (in degrees), closest first.
+func (gs *GeoSet[T]) Within(lat float64, lon float64, radiusMeters float64) []GeoResult[T] {
+	if radiusMeters < 0 {
+		return nil
+	}
+
+	gs.mu.RLock()
+	defer gs.mu.RUnlock()
+
+	return geoResults(lat, lon, gs.tree.within(toUnitVector(lat, lon), chordLength(radiusMeters)))
+}
+
+// Nearest returns an iterator over the entries in the set in order of their distance from the supplied latitude and
+// longitude (in degrees), closest first. Entries are found as they are iterated, so stopping early is cheap.
+// The set is locked for reading during iteration, so yield must not change the set.
+func (gs *GeoSet[T]) Nearest(lat float64, lon float64) func(yield func(GeoResult[T]) bool) {
+	return func(yield func(GeoResult[T]) bool) {
+		gs.mu.RLock()
+		defer gs.mu.RUnlock()
+
+		gs.tree.walk(toUnitVector(lat, lon), func(candidate kdCandidate[T]) bool {
+			return yield(geoResult(lat, lon, candidate))
+		})
+	}
+}
+
+func geoResults[T any](lat float64, lon float64, candidates []kdCandidate[T]) []GeoResult[T] {
+	if len(candidates) < 1 {
+		return nil
+	}
+
+	// Candidates are ordered by their chord distance, which is in the same order as the great-circle distance.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	results := make([]GeoResult[T], 0, len(candidates))
+	for _, candidate := range candidates {
+		results = append(results, geoResult(lat, lon, candidate))
+	}
+	return results
+}
+
+func geoResult[T any](lat float64, lon float64, candidate kdCandidate[T]) GeoResult[T] {
+	e := candidate.node.entry
+	return GeoResult[T]{
+		Key:       e.key,
+		Latitude:  e.latitude,
+		Longitude: e.longitude,
+		Value:     e.value,
+		Distance:  distance(lat, lon, e.latitude, e.longitude),
+	}
 }
 
 // haversine function
@@ -50,9 +185,6 @@ func hsin(theta float64) float64 {
 
 // See http://en.wikipedia.org/wiki/Haversine_formula
 func distance(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
-	// Radius of Earth in metres (mean earth radius, via https://en.wikipedia.org/wiki/Great-circle_distance)
-	r := float64(6371000)
-
 	// Convert degrees to radians
 	la1 := lat1 * math.Pi / 180
 	lo1 := lon1 * math.Pi / 180
@@ -60,5 +192,40 @@ func distance(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
 	lo2 := lon2 * math.Pi / 180
 
 	h := hsin(la2-la1) + math.Cos(la1)*math.Cos(la2)*hsin(lo2-lo1)
-	return 2 * r * math.Asin(math.Sqrt(h))
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// alertApplies returns whether the supplied latitude and longitude (in degrees) fall inside any of the areas of the
+// alert. Alerts which only describe their area by name apply everywhere.
+func alertApplies(alert *WeatherAlert, lat float64, lon float64) bool {
+	if len(alert.Polygons) > 0 {
+		for _, polygon := range alert.Polygons {
+			if len(polygon.Points) >= 3 && polygonContains(polygon.Points, lat, lon) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return len(alert.Polygon) < 3 || polygonContains(alert.Polygon, lat, lon)
+}
+
+// polygonContains returns whether the supplied latitude and longitude (in degrees) fall inside the polygon.
+// The polygon edges are treated as straight lines in latitude and longitude, which is accurate enough for the
+// areas covered by weather alerts.
+// See https://en.wikipedia.org/wiki/Point_in_polygon#Ray_casting_algorithm
+func polygonContains(polygon []*GeoPoint, lat float64, lon float64) bool {
+	inside := false
+
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a := polygon[i]
+		b := polygon[j]
+
+		if (a.Latitude > lat) != (b.Latitude > lat) &&
+			lon < (b.Longitude-a.Longitude)*(lat-a.Latitude)/(b.Latitude-a.Latitude)+a.Longitude {
+			inside = !inside
+		}
+	}
+
+	return inside
 }
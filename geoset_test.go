@@ -1,6 +1,11 @@
 package weather
 
 import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,7 +13,7 @@ import (
 
 type geosettest struct {
 	name         string
-	entries      []entry
+	entries      []entry[int]
 	closestValue int
 	searchLat    float64
 	searchLon    float64
@@ -17,15 +22,17 @@ type geosettest struct {
 var geosettests = []geosettest{
 	{
 		name: "closest to UW",
-		entries: []entry{
+		entries: []entry[int]{
 			{
 				// conestoga mall
+				key:       "conestoga",
 				latitude:  43.4977,
 				longitude: 80.5270,
 				value:     4785,
 			},
 			{
 				// fairview mall
+				key:       "fairview",
 				latitude:  43.4242,
 				longitude: 80.4392,
 				value:     5174,
@@ -41,15 +48,347 @@ var geosettests = []geosettest{
 func TestGeoSet_Closest(t *testing.T) {
 	for _, tt := range geosettests {
 		t.Run(tt.name, func(t *testing.T) {
-			geoset := NewGeoSet()
+			geoset := NewGeoSet[int]()
 			for _, entry := range tt.entries {
-				geoset.Add(entry.latitude, entry.longitude, entry.value)
+				geoset.Add(entry.key, entry.latitude, entry.longitude, entry.value)
 			}
-			val := geoset.Closest(tt.searchLat, tt.searchLon)
-			intVal, ok := val.(int)
+			val, dist, ok := geoset.Closest(tt.searchLat, tt.searchLon)
 			assert.True(t, ok)
-			assert.Equal(t, tt.closestValue, intVal)
+			assert.Equal(t, tt.closestValue, val)
+			assert.Greater(t, dist, float64(0))
 		})
 	}
+}
+
+// randomEntries returns n entries spread over the whole globe, with their index as their key and value.
+func randomEntries(n int) []entry[int] {
+	r := rand.New(rand.NewSource(1))
+
+	entries := make([]entry[int], n)
+	for i := range entries {
+		entries[i] = entry[int]{
+			key: strconv.Itoa(i),
+			// Uniformly distributed over the sphere, rather than clustered at the poles.
+			latitude:  math.Asin(2*r.Float64()-1) * 180 / math.Pi,
+			longitude: r.Float64()*360 - 180,
+			value:     i,
+		}
+	}
+	return entries
+}
+
+// linearNearest returns the values of the entries in order of their distance from the supplied location.
+func linearNearest(entries []entry[int], lat float64, lon float64) []int {
+	distances := make([]float64, len(entries))
+	indexes := make([]int, len(entries))
+	for i, e := range entries {
+		distances[i] = distance(lat, lon, e.latitude, e.longitude)
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return distances[indexes[i]] < distances[indexes[j]]
+	})
+
+	var values []int
+	for _, i := range indexes {
+		values = append(values, entries[i].value)
+	}
+	return values
+}
+
+func resultValues[T any](results []GeoResult[T]) []T {
+	var values []T
+	for _, result := range results {
+		values = append(values, result.Value)
+	}
+	return values
+}
+
+func TestGeoSet_KNearest(t *testing.T) {
+	entries := randomEntries(2000)
+
+	// Adding entries in sorted order would leave the tree unbalanced if it weren't rebuilt.
+	sorted := append([]entry[int]{}, entries...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].latitude < sorted[j].latitude
+	})
+
+	for name, order := range map[string][]entry[int]{"random": entries, "sorted": sorted} {
+		t.Run(name, func(t *testing.T) {
+			geoset := NewGeoSet[int]()
+			for _, e := range order {
+				geoset.Add(e.key, e.latitude, e.longitude, e.value)
+			}
+
+			for _, search := range randomEntries(50) {
+				results := geoset.KNearest(search.latitude, search.longitude, 10)
+				assert.Equal(t, linearNearest(entries, search.latitude, search.longitude)[:10], resultValues(results))
+
+				for i, result := range results {
+					e := entries[result.Value]
+					assert.Equal(t, e.key, result.Key)
+					assert.Equal(t, e.latitude, result.Latitude)
+					assert.Equal(t, e.longitude, result.Longitude)
+					assert.InDelta(t, distance(search.latitude, search.longitude, e.latitude, e.longitude), result.Distance, 0.001)
+					if i > 0 {
+						assert.GreaterOrEqual(t, result.Distance, results[i-1].Distance)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGeoSet_KNearestFewEntries(t *testing.T) {
+	geoset := NewGeoSet[string]()
+	_, _, ok := geoset.Closest(43.4723, -80.5449)
+	assert.False(t, ok)
+	assert.Empty(t, geoset.KNearest(43.4723, -80.5449, 3))
+
+	// Either side of the antimeridian.
+	geoset.Add("fiji", -17.7134, 178.0650, "Fiji")
+	geoset.Add("samoa", -13.7590, -172.1046, "Samoa")
+	geoset.Add("kitchener", 43.4516, -80.4925, "Kitchener")
+
+	results := geoset.KNearest(-16.0, 179.9, 5)
+	assert.Equal(t, []string{"Fiji", "Samoa", "Kitchener"}, resultValues(results))
+	assert.Empty(t, geoset.KNearest(-16.0, 179.9, 0))
+}
+
+func TestGeoSet_Within(t *testing.T) {
+	entries := randomEntries(2000)
+
+	geoset := NewGeoSet[int]()
+	for _, e := range entries {
+		geoset.Add(e.key, e.latitude, e.longitude, e.value)
+	}
+
+	for _, radius := range []float64{0, 100000, 500000, 2000000, 30000000} {
+		for _, search := range randomEntries(20) {
+			var expected []int
+			for _, value := range linearNearest(entries, search.latitude, search.longitude) {
+				e := entries[value]
+				if distance(search.latitude, search.longitude, e.latitude, e.longitude) <= radius {
+					expected = append(expected, value)
+				}
+			}
+
+			results := geoset.Within(search.latitude, search.longitude, radius)
+			assert.Equal(t, expected, resultValues(results), "radius %f", radius)
+		}
+	}
+
+	assert.Empty(t, geoset.Within(43.4723, -80.5449, -1))
+}
+
+func TestGeoSet_Nearest(t *testing.T) {
+	entries := randomEntries(500)
+
+	geoset := NewGeoSet[int]()
+	for _, e := range entries {
+		geoset.Add(e.key, e.latitude, e.longitude, e.value)
+	}
+	// Removed entries are skipped.
+	geoset.Remove(entries[0].key)
+	remaining := entries[1:]
+
+	for _, search := range randomEntries(20) {
+		var values []int
+		geoset.Nearest(search.latitude, search.longitude)(func(result GeoResult[int]) bool {
+			values = append(values, result.Value)
+			return true
+		})
+		assert.Equal(t, linearNearest(remaining, search.latitude, search.longitude), values)
+	}
+
+	// Iteration stops once yield returns false.
+	var results []GeoResult[int]
+	geoset.Nearest(43.4723, -80.5449)(func(result GeoResult[int]) bool {
+		results = append(results, result)
+		return len(results) < 3
+	})
+	assert.Equal(t, geoset.KNearest(43.4723, -80.5449, 3), results)
+
+	NewGeoSet[int]().Nearest(43.4723, -80.5449)(func(result GeoResult[int]) bool {
+		assert.Fail(t, "empty set yielded a result")
+		return true
+	})
+}
+
+func TestGeoSet_UpdateRemove(t *testing.T) {
+	geoset := NewGeoSet[string]()
+	geoset.Add("conestoga", 43.4977, -80.5270, "Conestoga Mall")
+	geoset.Add("fairview", 43.4242, -80.4392, "Fairview Mall")
+	assert.Equal(t, 2, geoset.Len())
+
+	val, _, ok := geoset.Closest(43.4723, -80.5449)
+	assert.True(t, ok)
+	assert.Equal(t, "Conestoga Mall", val)
+
+	// Updating the value in place.
+	assert.True(t, geoset.Update("conestoga", 43.4977, -80.5270, "Conestoga"))
+	val, _, _ = geoset.Closest(43.4723, -80.5449)
+	assert.Equal(t, "Conestoga", val)
+
+	// Moving an entry, and adding an existing key, replaces the entry rather than adding another.
+	assert.True(t, geoset.Update("conestoga", 43.3616, -80.3144, "Cambridge Centre"))
+	geoset.Add("fairview", 43.4242, -80.4392, "Fairview Park")
+	assert.Equal(t, 2, geoset.Len())
+	assert.Equal(t, []string{"Fairview Park", "Cambridge Centre"}, resultValues(geoset.KNearest(43.4723, -80.5449, 5)))
+
+	assert.False(t, geoset.Update("boardwalk", 43.4348, -80.5665, "The Boardwalk"))
+	assert.Equal(t, 2, geoset.Len())
 
+	assert.True(t, geoset.Remove("fairview"))
+	assert.False(t, geoset.Remove("fairview"))
+	val, _, ok = geoset.Closest(43.4723, -80.5449)
+	assert.True(t, ok)
+	assert.Equal(t, "Cambridge Centre", val)
+
+	assert.True(t, geoset.Remove("conestoga"))
+	_, _, ok = geoset.Closest(43.4723, -80.5449)
+	assert.False(t, ok)
+	assert.Equal(t, 0, geoset.Len())
+}
+
+func TestGeoSet_RemoveMany(t *testing.T) {
+	entries := randomEntries(2000)
+
+	geoset := NewGeoSet[int]()
+	for _, e := range entries {
+		geoset.Add(e.key, e.latitude, e.longitude, e.value)
+	}
+
+	// Removing most of the entries causes the tree to be rebuilt without them.
+	var remaining []entry[int]
+	for i, e := range entries {
+		if i%4 == 0 {
+			remaining = append(remaining, e)
+			continue
+		}
+		assert.True(t, geoset.Remove(e.key))
+	}
+	assert.Equal(t, len(remaining), geoset.Len())
+
+	for _, search := range randomEntries(20) {
+		expected := linearNearest(remaining, search.latitude, search.longitude)[:5]
+		assert.Equal(t, expected, resultValues(geoset.KNearest(search.latitude, search.longitude, 5)))
+	}
+}
+
+func TestGeoSet_Concurrent(t *testing.T) {
+	entries := randomEntries(1000)
+
+	geoset := NewGeoSet[int]()
+	for _, e := range entries[:500] {
+		geoset.Add(e.key, e.latitude, e.longitude, e.value)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, search := range randomEntries(200) {
+				_, _, ok := geoset.Closest(search.latitude, search.longitude)
+				assert.True(t, ok)
+				geoset.Within(search.latitude, search.longitude, 500000)
+			}
+		}()
+	}
+
+	for i, e := range entries[500:] {
+		geoset.Add(e.key, e.latitude, e.longitude, e.value)
+		geoset.Remove(entries[i].key)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 500, geoset.Len())
+}
+
+const benchmarkEntries = 10000
+
+func newBenchmarkGeoSet() *GeoSet[int] {
+	geoset := NewGeoSet[int]()
+	for _, e := range randomEntries(benchmarkEntries) {
+		geoset.Add(e.key, e.latitude, e.longitude, e.value)
+	}
+	return geoset
+}
+
+func BenchmarkGeoSet_Add(b *testing.B) {
+	entries := randomEntries(benchmarkEntries)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		geoset := NewGeoSet[int]()
+		for _, e := range entries {
+			geoset.Add(e.key, e.latitude, e.longitude, e.value)
+		}
+	}
+}
+
+func BenchmarkGeoSet_Closest(b *testing.B) {
+	geoset := newBenchmarkGeoSet()
+	searches := randomEntries(1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		search := searches[i%len(searches)]
+		geoset.Closest(search.latitude, search.longitude)
+	}
+}
+
+// BenchmarkGeoSet_ClosestLinear is the cost of comparing against every entry, as GeoSet used to.
+func BenchmarkGeoSet_ClosestLinear(b *testing.B) {
+	entries := randomEntries(benchmarkEntries)
+	searches := randomEntries(1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		search := searches[i%len(searches)]
+		shortestDistance := math.MaxFloat64
+		for _, e := range entries {
+			if d := distance(search.latitude, search.longitude, e.latitude, e.longitude); d < shortestDistance {
+				shortestDistance = d
+			}
+		}
+	}
+}
+
+func BenchmarkGeoSet_KNearest(b *testing.B) {
+	geoset := newBenchmarkGeoSet()
+	searches := randomEntries(1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		search := searches[i%len(searches)]
+		geoset.KNearest(search.latitude, search.longitude, 10)
+	}
+}
+
+func BenchmarkGeoSet_Within(b *testing.B) {
+	geoset := newBenchmarkGeoSet()
+	searches := randomEntries(1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		search := searches[i%len(searches)]
+		geoset.Within(search.latitude, search.longitude, 250000)
+	}
+}
+
+func BenchmarkGeoSet_Nearest(b *testing.B) {
+	geoset := newBenchmarkGeoSet()
+	searches := randomEntries(1000)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		search := searches[i%len(searches)]
+		found := 0
+		geoset.Nearest(search.latitude, search.longitude)(func(result GeoResult[int]) bool {
+			found++
+			return found < 10
+		})
+	}
 }
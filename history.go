@@ -0,0 +1,311 @@
+package weather
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	historyDayLayout = "2006-01-02"
+	historyFileExt   = ".pb"
+)
+
+// HistoryStore records the reports of each station so that past observations can be queried.
+// The reports of each station are kept in their own directory, with one file per UTC day containing
+// length-delimited WeatherReport messages in the order they were recorded.
+type HistoryStore struct {
+	logger    *zap.Logger
+	dir       string
+	retention time.Duration
+
+	mu       sync.Mutex
+	stations map[string]*stationHistory
+}
+
+// stationHistory guards the files of a single station, so that reading the history of one station doesn't hold up
+// recording the reports of the others.
+type stationHistory struct {
+	mu   sync.RWMutex
+	last *WeatherReport
+	// repaired is the path of the file which has been checked for a truncated record since it was last written to.
+	repaired string
+}
+
+// NewHistoryStore creates a new store which records reports in the supplied directory, creating it if required.
+// Reports are kept for the retention period; zero means they are kept forever.
+func NewHistoryStore(logger *zap.Logger, dir string, retention time.Duration) (*HistoryStore, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoryStore{
+		logger:    logger,
+		dir:       dir,
+		retention: retention,
+		stations:  map[string]*stationHistory{},
+	}, nil
+}
+
+// station returns the history of the station with the supplied key, creating it if required.
+func (hs *HistoryStore) station(key string) *stationHistory {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	sh := hs.stations[key]
+	if sh == nil {
+		sh = &stationHistory{}
+		hs.stations[key] = sh
+	}
+	return sh
+}
+
+// Record adds the supplied report to the history of the station.
+// The report is ignored if it is the same as the last report recorded for the station.
+// Reports without an observation time are recorded as observed now.
+func (hs *HistoryStore) Record(station Station, report *WeatherReport) error {
+	key := stationKey(station)
+	sh := hs.station(key)
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if sh.last != nil && sameReport(sh.last, report) {
+		return nil
+	}
+
+	recorded := report
+	if report.ObservedAt == nil {
+		recorded = proto.Clone(report).(*WeatherReport)
+		recorded.ObservedAt = timestamppb.Now()
+	}
+
+	stationDir := filepath.Join(hs.dir, key)
+	err := os.MkdirAll(stationDir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	day := recorded.ObservedAt.AsTime().UTC().Format(historyDayLayout)
+	path := filepath.Join(stationDir, day+historyFileExt)
+
+	// Old files are only removed when a new one is started, as retention is measured in days.
+	_, statErr := os.Stat(path)
+	newFile := errors.Is(statErr, os.ErrNotExist)
+
+	// Appending after a truncated record would corrupt every record that follows it, so it is removed first.
+	if !newFile && sh.repaired != path {
+		err = hs.repair(path)
+		if err != nil {
+			return err
+		}
+	}
+	sh.repaired = path
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	_, err = protodelim.MarshalTo(f, recorded)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		sh.repaired = ""
+		return err
+	}
+	sh.last = report
+
+	if newFile {
+		hs.prune(stationDir, time.Now())
+	}
+	return nil
+}
+
+// Query returns the reports of the station observed at or after start and before end, oldest first.
+// Reports older than the retention period are never returned, even if they haven't been removed yet.
+func (hs *HistoryStore) Query(station Station, start time.Time, end time.Time) ([]*WeatherReport, error) {
+	if hs.retention > 0 {
+		if cutoff := time.Now().Add(-hs.retention); start.Before(cutoff) {
+			start = cutoff
+		}
+	}
+	if !start.Before(end) {
+		return nil, nil
+	}
+
+	key := stationKey(station)
+	sh := hs.station(key)
+
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+
+	// Only the files which exist are read, so the cost of a query doesn't depend on the length of the range.
+	stationDir := filepath.Join(hs.dir, key)
+	entries, err := os.ReadDir(stationDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	firstDay := start.UTC().Truncate(time.Hour * 24)
+	var reports []*WeatherReport
+	for _, entry := range entries {
+		day, err := time.Parse(historyDayLayout, strings.TrimSuffix(entry.Name(), historyFileExt))
+		if err != nil || day.Before(firstDay) || !day.Before(end) {
+			continue
+		}
+
+		dayReports, _, err := readHistoryFile(filepath.Join(stationDir, entry.Name()))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, report := range dayReports {
+			observedAt := report.ObservedAt.AsTime()
+			if observedAt.Before(start) || !observedAt.Before(end) {
+				continue
+			}
+			// The last report before a restart may be recorded again after it.
+			if len(reports) > 0 && sameReport(reports[len(reports)-1], report) {
+				continue
+			}
+			reports = append(reports, report)
+		}
+	}
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].ObservedAt.AsTime().Before(reports[j].ObservedAt.AsTime())
+	})
+	return reports, nil
+}
+
+// prune removes the files of the station directory which are entirely older than the retention period.
+func (hs *HistoryStore) prune(stationDir string, now time.Time) {
+	if hs.retention <= 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(stationDir)
+	if err != nil {
+		hs.logger.Warn("error listing history files",
+			zap.String("path", stationDir),
+			zap.Error(err),
+		)
+		return
+	}
+
+	cutoff := now.Add(-hs.retention)
+	for _, entry := range entries {
+		day, err := time.Parse(historyDayLayout, strings.TrimSuffix(entry.Name(), historyFileExt))
+		if err != nil || !day.Add(time.Hour*24).Before(cutoff) {
+			continue
+		}
+
+		err = os.Remove(filepath.Join(stationDir, entry.Name()))
+		if err != nil {
+			hs.logger.Warn("error removing history file",
+				zap.String("path", entry.Name()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// repair truncates the history file to the end of its last complete record.
+func (hs *HistoryStore) repair(path string) error {
+	_, length, err := readHistoryFile(path)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	} else if info.Size() <= length {
+		return nil
+	}
+
+	hs.logger.Warn("removing truncated record from history file",
+		zap.String("path", path),
+		zap.Int64("size", info.Size()),
+		zap.Int64("length", length),
+	)
+	return os.Truncate(path, length)
+}
+
+// readHistoryFile returns the reports in the history file, and the length of the file up to the end of the last
+// complete record.
+func readHistoryFile(path string) ([]*WeatherReport, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	r := &historyReader{
+		r: bufio.NewReader(f),
+	}
+
+	var reports []*WeatherReport
+	var length int64
+	for {
+		report := &WeatherReport{}
+		err = protodelim.UnmarshalFrom(r, report)
+		if errors.Is(err, io.EOF) {
+			return reports, length, nil
+		} else if err != nil {
+			// A write interrupted by a crash leaves a truncated record at the end of the file.
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				return reports, length, nil
+			}
+			return nil, 0, err
+		}
+		reports = append(reports, report)
+		length = r.n
+	}
+}
+
+// historyReader counts the bytes read from a history file.
+type historyReader struct {
+	r *bufio.Reader
+	n int64
+}
+
+func (hr *historyReader) Read(p []byte) (int, error) {
+	n, err := hr.r.Read(p)
+	hr.n += int64(n)
+	return n, err
+}
+
+func (hr *historyReader) ReadByte() (byte, error) {
+	b, err := hr.r.ReadByte()
+	if err == nil {
+		hr.n++
+	}
+	return b, err
+}
+
+// sameReport returns true if the supplied reports describe the same observation.
+func sameReport(a *WeatherReport, b *WeatherReport) bool {
+	if len(a.ObservationId) > 0 || len(b.ObservationId) > 0 {
+		return a.ObservationId == b.ObservationId
+	}
+	return proto.Equal(a, b)
+}
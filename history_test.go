@@ -0,0 +1,164 @@
+package weather
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHistoryStore_RecordQuery(t *testing.T) {
+	store, err := NewHistoryStore(zap.NewNop(), t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	station := &testStation{
+		name:      "Kitchener Waterloo",
+		latitude:  43.451,
+		longitude: -80.488,
+	}
+
+	observedAt := time.Date(2024, time.November, 20, 22, 0, 0, 0, time.UTC)
+	for i, id := range []string{"obs-1", "obs-1", "obs-2", "obs-3", "obs-4"} {
+		err = store.Record(station, &WeatherReport{
+			ObservationId: id,
+			ObservedAt:    timestamppb.New(observedAt.Add(time.Hour * time.Duration(i))),
+		})
+		assert.NoError(t, err)
+	}
+
+	// The range spans two days, and repeated reports are only recorded once.
+	reports, err := store.Query(station, observedAt, observedAt.Add(time.Hour*4))
+	assert.NoError(t, err)
+
+	var ids []string
+	for _, report := range reports {
+		ids = append(ids, report.ObservationId)
+	}
+	assert.Equal(t, []string{"obs-1", "obs-2", "obs-3"}, ids)
+
+	// Other stations have their own history.
+	reports, err = store.Query(&testStation{name: "Waterloo"}, observedAt, observedAt.Add(time.Hour*4))
+	assert.NoError(t, err)
+	assert.Empty(t, reports)
+}
+
+func TestHistoryStore_RecordWithoutObservationTime(t *testing.T) {
+	store, err := NewHistoryStore(zap.NewNop(), t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	station := &testStation{name: "San Francisco"}
+	report := &WeatherReport{
+		Conditions: &WeatherCondition{Temperature: 14.4},
+	}
+	assert.NoError(t, store.Record(station, report))
+	assert.NoError(t, store.Record(station, report))
+	assert.Nil(t, report.ObservedAt)
+
+	reports, err := store.Query(station, time.Now().Add(-time.Minute), time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, reports, 1)
+	assert.NotNil(t, reports[0].ObservedAt)
+}
+
+func TestHistoryStore_Retention(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewHistoryStore(zap.NewNop(), dir, time.Hour*24*7)
+	assert.NoError(t, err)
+
+	station := &testStation{name: "Kitchener Waterloo"}
+	now := time.Now().UTC()
+
+	assert.NoError(t, store.Record(station, &WeatherReport{
+		ObservationId: "old",
+		ObservedAt:    timestamppb.New(now.Add(-time.Hour * 24 * 10)),
+	}))
+	assert.NoError(t, store.Record(station, &WeatherReport{
+		ObservationId: "recent",
+		ObservedAt:    timestamppb.New(now.Add(-time.Hour * 24 * 3)),
+	}))
+	assert.NoError(t, store.Record(station, &WeatherReport{
+		ObservationId: "new",
+		ObservedAt:    timestamppb.New(now),
+	}))
+
+	files, err := os.ReadDir(filepath.Join(dir, stationKey(station)))
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	reports, err := store.Query(station, now.Add(-time.Hour*24*30), now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, reports, 2)
+	assert.Equal(t, "recent", reports[0].ObservationId)
+}
+
+func TestHistoryStore_TruncatedFile(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewHistoryStore(zap.NewNop(), dir, 0)
+	assert.NoError(t, err)
+
+	station := &testStation{name: "Kitchener Waterloo"}
+	observedAt := time.Date(2024, time.November, 20, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, store.Record(station, &WeatherReport{
+		ObservationId: "obs-1",
+		ObservedAt:    timestamppb.New(observedAt),
+	}))
+
+	// Simulate a crash part way through writing a record.
+	path := filepath.Join(dir, stationKey(station), "2024-11-20.pb")
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte{0x20, 0x0a})
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	reports, err := store.Query(station, observedAt.Add(-time.Hour), observedAt.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Len(t, reports, 1)
+
+	// Records appended after a restart aren't lost behind the truncated one.
+	store, err = NewHistoryStore(zap.NewNop(), dir, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Record(station, &WeatherReport{
+		ObservationId: "obs-2",
+		ObservedAt:    timestamppb.New(observedAt.Add(time.Minute * 30)),
+	}))
+	assert.NoError(t, store.Record(station, &WeatherReport{
+		ObservationId: "obs-3",
+		ObservedAt:    timestamppb.New(observedAt.Add(time.Minute * 45)),
+	}))
+
+	reports, err = store.Query(station, observedAt.Add(-time.Hour), observedAt.Add(time.Hour))
+	assert.NoError(t, err)
+	if assert.Len(t, reports, 3) {
+		assert.Equal(t, "obs-1", reports[0].ObservationId)
+		assert.Equal(t, "obs-2", reports[1].ObservationId)
+		assert.Equal(t, "obs-3", reports[2].ObservationId)
+	}
+}
+
+func TestHistoryStore_QueryLongRange(t *testing.T) {
+	store, err := NewHistoryStore(zap.NewNop(), t.TempDir(), 0)
+	assert.NoError(t, err)
+
+	station := &testStation{name: "Kitchener Waterloo"}
+	observedAt := time.Date(2024, time.November, 20, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, store.Record(station, &WeatherReport{
+		ObservationId: "obs-1",
+		ObservedAt:    timestamppb.New(observedAt),
+	}))
+
+	// Only the files which exist are read; reading one for every day of the range would take minutes.
+	reports, err := store.Query(station, time.Time{}, time.Date(9999, time.January, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Len(t, reports, 1)
+
+	// Reports older than the retention period aren't returned, even if they haven't been removed yet.
+	store.retention = time.Hour * 24 * 7
+	reports, err = store.Query(station, time.Time{}, time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, reports)
+}
@@ -0,0 +1,287 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultUserAgent is used to identify requests to upstream providers if no other user agent is configured.
+	DefaultUserAgent = "weatherd (github.com/rmrobinson/weather)"
+
+	defaultMaxAttempts      = 3
+	defaultRetryBackoff     = time.Millisecond * 500
+	defaultMaxRetryBackoff  = time.Second * 30
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = time.Minute
+)
+
+var (
+	// ErrCircuitOpen is returned if requests to an upstream host are being rejected after repeated failures.
+	ErrCircuitOpen = errors.New("circuit breaker open")
+	// ErrUnexpectedStatusCode is returned by providers if an upstream host responds with an unexpected status code.
+	ErrUnexpectedStatusCode = errors.New("unexpected status code")
+)
+
+// HTTPConfig describes how requests are made to an upstream provider.
+type HTTPConfig struct {
+	// UserAgent identifies the application, and ideally a contact, to the upstream provider.
+	UserAgent string
+	// Accept is the media type requested from the upstream provider. The header is not sent if this is empty.
+	Accept string
+	// Headers are added to every request made to the upstream provider.
+	Headers map[string]string
+	// Timeout limits the time taken by each request, including reading the response body. Zero means no timeout.
+	Timeout time.Duration
+
+	// MaxAttempts is the number of times a request is attempted if it fails with a transient error,
+	// a server error or a 429 status. Defaults to 3.
+	MaxAttempts int
+	// RetryBackoff is the delay before the first retry, which doubles with each subsequent retry
+	// and is randomized to spread out retries. Defaults to 500ms.
+	RetryBackoff time.Duration
+	// MaxRetryBackoff limits the delay between retries. A request isn't retried if the upstream provider
+	// asks for a longer delay with Retry-After. Defaults to 30s.
+	MaxRetryBackoff time.Duration
+	// BreakerThreshold is the number of consecutive failed requests to a host before further requests
+	// to it are rejected. Defaults to 5.
+	BreakerThreshold int
+	// BreakerCooldown is the time requests to a host are rejected for before a trial request is allowed.
+	// Defaults to 1m.
+	BreakerCooldown time.Duration
+}
+
+// HTTPClient performs requests to an upstream provider, applying the provider's HTTP configuration to each one.
+// Requests which fail with transient errors are retried, and a circuit breaker per host stops requests being made
+// to a host which is repeatedly failing.
+type HTTPClient struct {
+	logger *zap.Logger
+	config HTTPConfig
+	client *http.Client
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+
+	cache   responseCache
+	offline *OfflineSwitch
+}
+
+// NewHTTPClient creates a new client using the supplied configuration.
+func NewHTTPClient(logger *zap.Logger, config HTTPConfig) *HTTPClient {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if len(config.UserAgent) < 1 {
+		config.UserAgent = DefaultUserAgent
+	}
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = defaultRetryBackoff
+	}
+	if config.MaxRetryBackoff <= 0 {
+		config.MaxRetryBackoff = defaultMaxRetryBackoff
+	}
+	if config.BreakerThreshold < 1 {
+		config.BreakerThreshold = defaultBreakerThreshold
+	}
+	if config.BreakerCooldown <= 0 {
+		config.BreakerCooldown = defaultBreakerCooldown
+	}
+
+	return &HTTPClient{
+		logger: logger,
+		config: config,
+		client: &http.Client{
+			Timeout: config.Timeout,
+		},
+		breakers: map[string]*circuitBreaker{},
+	}
+}
+
+// SetOfflineSwitch sets the switch which controls offline mode. While offline, requests fail with ErrOffline.
+func (c *HTTPClient) SetOfflineSwitch(offline *OfflineSwitch) {
+	c.offline = offline
+}
+
+// Do performs the supplied request after adding the configured headers.
+// Headers already set on the request take precedence over the configured headers.
+// Requests are retried if they fail with a transient error, a server error or a 429 status, in which case the
+// response to the final attempt is returned. Callers are responsible for checking the status code of the response.
+//
+// GET requests are made conditional on the ETag or Last-Modified header of the previous response to the same URL.
+// If the upstream provider reports the response is unchanged, the previous response is returned with its
+// headers updated, so callers don't need to handle 304 responses themselves.
+func (c *HTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if c.offline.Offline() {
+		return nil, ErrOffline
+	}
+
+	setHeader := func(key string, value string) {
+		if len(value) > 0 && len(req.Header.Get(key)) < 1 {
+			req.Header.Set(key, value)
+		}
+	}
+
+	setHeader("User-Agent", c.config.UserAgent)
+	setHeader("Accept", c.config.Accept)
+	for key, value := range c.config.Headers {
+		setHeader(key, value)
+	}
+
+	entry := c.cache.prepare(req)
+	resp, err := c.doWithRetries(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		c.breaker(req.URL.Host).metrics.Add("not_modified", 1)
+	}
+	return c.cache.update(req, resp, entry)
+}
+
+// doWithRetries performs the supplied request, retrying it while it fails with a transient error.
+func (c *HTTPClient) doWithRetries(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	breaker := c.breaker(req.URL.Host)
+	metrics := breaker.metrics
+
+	for attempt := 1; ; attempt++ {
+		if !breaker.allow(time.Now()) {
+			return nil, fmt.Errorf("%w for %s", ErrCircuitOpen, req.URL.Host)
+		}
+
+		attemptReq, err := c.attemptRequest(req, attempt)
+		if err != nil {
+			breaker.abandon()
+			return nil, err
+		}
+
+		metrics.Add("requests", 1)
+		resp, err := c.client.Do(attemptReq)
+		if err != nil && ctx.Err() != nil {
+			breaker.abandon()
+			return nil, err
+		} else if err == nil && !retryableStatus(resp.StatusCode) {
+			breaker.success()
+			return resp, nil
+		}
+		breaker.failure(time.Now())
+
+		delay, retry := c.retryDelay(resp, attempt)
+		if !retry || (attemptReq.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		fields := []zap.Field{
+			zap.String("url", req.URL.String()),
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+		}
+		if err != nil {
+			fields = append(fields, zap.Error(err))
+		} else {
+			fields = append(fields, zap.Int("status_code", resp.StatusCode))
+
+			// The body must be consumed for the connection to be reused.
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		c.logger.Info("retrying upstream request", fields...)
+		metrics.Add("retries", 1)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// attemptRequest returns the request to send for the supplied attempt.
+// Retries need a fresh copy of the request body, if there is one.
+func (c *HTTPClient) attemptRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 1 || req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	attemptReq := req.Clone(req.Context())
+	attemptReq.Body = body
+	return attemptReq, nil
+}
+
+// retryDelay returns the time to wait before the next attempt, and false if the request shouldn't be retried.
+func (c *HTTPClient) retryDelay(resp *http.Response, attempt int) (time.Duration, bool) {
+	if attempt >= c.config.MaxAttempts {
+		return 0, false
+	}
+
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return delay, delay <= c.config.MaxRetryBackoff
+		}
+	}
+
+	// Full jitter; see https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+	backoff := c.config.RetryBackoff << (attempt - 1)
+	if backoff <= 0 || backoff > c.config.MaxRetryBackoff {
+		backoff = c.config.MaxRetryBackoff
+	}
+	return time.Duration(rand.Int63n(int64(backoff)) + 1), true
+}
+
+func (c *HTTPClient) breaker(host string) *circuitBreaker {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.breakers[host]
+	if !ok {
+		b = newCircuitBreaker(c.logger, host, c.config.BreakerThreshold, c.config.BreakerCooldown)
+		c.breakers[host] = b
+	}
+	return b
+}
+
+// retryableStatus returns true if the status code indicates the upstream host may handle the request if it is retried.
+func retryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if len(value) < 1 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		delay := t.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
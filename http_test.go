@@ -0,0 +1,242 @@
+package weather
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestHTTPClient_Do(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(zap.NewNop(), HTTPConfig{
+		UserAgent: "weathertest (weather@example.com)",
+		Accept:    "application/geo+json",
+		Headers: map[string]string{
+			"X-Api-Key":       "secret",
+			"Accept-Language": "en-CA",
+		},
+		Timeout: time.Second,
+	})
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+	req.Header.Set("Accept-Language", "fr-CA")
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "weathertest (weather@example.com)", received.Get("User-Agent"))
+	assert.Equal(t, "application/geo+json", received.Get("Accept"))
+	assert.Equal(t, "secret", received.Get("X-Api-Key"))
+	// Headers set on the request take precedence over the configured ones.
+	assert.Equal(t, "fr-CA", received.Get("Accept-Language"))
+}
+
+func TestHTTPClient_DoDefaultUserAgent(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(zap.NewNop(), HTTPConfig{})
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, DefaultUserAgent, received.Get("User-Agent"))
+	assert.Empty(t, received.Get("Accept"))
+}
+
+func TestHTTPClient_DoRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []int
+		retryAfter string
+		attempts   int32
+		statusCode int
+	}{
+		{
+			name:       "server errors until success",
+			statuses:   []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			attempts:   3,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "rate limited",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter: "0",
+			attempts:   2,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "retry after exceeds the max backoff",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter: "120",
+			attempts:   1,
+			statusCode: http.StatusTooManyRequests,
+		},
+		{
+			name:       "client errors aren't retried",
+			statuses:   []int{http.StatusNotFound, http.StatusOK},
+			attempts:   1,
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "attempts exhausted",
+			statuses:   []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK},
+			attempts:   3,
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+				if len(tt.retryAfter) > 0 {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(tt.statuses[attempt-1])
+			}))
+			defer server.Close()
+
+			client := NewHTTPClient(zap.NewNop(), HTTPConfig{
+				RetryBackoff:    time.Millisecond,
+				MaxRetryBackoff: time.Millisecond * 10,
+			})
+
+			req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			assert.NoError(t, err)
+
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, tt.statusCode, resp.StatusCode)
+			assert.Equal(t, tt.attempts, attempts.Load())
+		})
+	}
+}
+
+func TestHTTPClient_DoCircuitBreaker(t *testing.T) {
+	var attempts atomic.Int32
+	var healthy atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(zap.NewNop(), HTTPConfig{
+		MaxAttempts:      1,
+		BreakerThreshold: 3,
+		BreakerCooldown:  time.Millisecond * 20,
+	})
+
+	do := func() (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+
+		resp, err := client.Do(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return resp, err
+	}
+
+	for i := 0; i < 3; i++ {
+		resp, err := do()
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	}
+
+	// Once open, requests are rejected without reaching the host.
+	_, err := do()
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(3), attempts.Load())
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	metrics := upstreamMetrics.Get(host).(*expvar.Map)
+	assert.Equal(t, "open", metrics.Get("breaker_state").(*expvar.String).Value())
+
+	// After the cooldown a trial request is allowed, which closes the breaker if it succeeds.
+	time.Sleep(time.Millisecond * 25)
+	healthy.Store(true)
+
+	resp, err := do()
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "closed", metrics.Get("breaker_state").(*expvar.String).Value())
+	assert.Equal(t, int64(1), metrics.Get("opened").(*expvar.Int).Value())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, time.November, 20, 19, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		delay time.Duration
+		ok    bool
+	}{
+		{"120", time.Minute * 2, true},
+		{"Wed, 20 Nov 2024 19:00:30 GMT", time.Second * 30, true},
+		{"Wed, 20 Nov 2024 18:00:00 GMT", 0, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			delay, ok := parseRetryAfter(tt.value, now)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.delay, delay)
+		})
+	}
+}
+
+func TestHTTPClient_DoOffline(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+	}))
+	defer server.Close()
+
+	offline := NewOfflineSwitch(zap.NewNop(), true)
+	client := NewHTTPClient(zap.NewNop(), HTTPConfig{})
+	client.SetOfflineSwitch(offline)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	_, err = client.Do(req)
+	assert.ErrorIs(t, err, ErrOffline)
+	assert.Equal(t, int32(0), attempts.Load())
+
+	offline.Set(false)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, int32(1), attempts.Load())
+}
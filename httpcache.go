@@ -0,0 +1,164 @@
+package weather
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxCachedBodySize limits the size of the responses which are kept for revalidation.
+const maxCachedBodySize = 8 << 20
+
+// cachedResponse is a previously retrieved response along with the validators used to check if it has changed.
+type cachedResponse struct {
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+}
+
+// responseCache keeps the responses to GET requests which include an ETag or Last-Modified header,
+// so that later requests for the same URL can be made conditional and not download an unchanged body again.
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+}
+
+func (rc *responseCache) get(key string) *cachedResponse {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	return rc.entries[key]
+}
+
+func (rc *responseCache) set(key string, entry *cachedResponse) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if rc.entries == nil {
+		rc.entries = map[string]*cachedResponse{}
+	}
+	rc.entries[key] = entry
+}
+
+// prepare adds the validators of the cached response, if any, to the supplied request.
+// Requests which already have their own validators are left unchanged, as are requests other than GET.
+func (rc *responseCache) prepare(req *http.Request) *cachedResponse {
+	if req.Method != http.MethodGet || len(req.Header.Get("If-None-Match")) > 0 || len(req.Header.Get("If-Modified-Since")) > 0 {
+		return nil
+	}
+
+	entry := rc.get(req.URL.String())
+	if entry == nil {
+		return nil
+	}
+
+	if len(entry.etag) > 0 {
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+	if len(entry.lastModified) > 0 {
+		req.Header.Set("If-Modified-Since", entry.lastModified)
+	}
+	return entry
+}
+
+// update records the supplied response, and returns the response to pass on to the caller.
+// A 304 response to a conditional request is replaced by the cached response, with its headers updated.
+func (rc *responseCache) update(req *http.Request, resp *http.Response, entry *cachedResponse) (*http.Response, error) {
+	key := req.URL.String()
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		// The headers of a 304 response update those of the cached response; see RFC 9111 section 4.3.4.
+		header := entry.header.Clone()
+		for name, values := range resp.Header {
+			header[name] = values
+		}
+		rc.set(key, &cachedResponse{
+			etag:         entry.etag,
+			lastModified: entry.lastModified,
+			header:       header,
+			body:         entry.body,
+		})
+
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(entry.body)),
+			ContentLength: int64(len(entry.body)),
+			Request:       resp.Request,
+		}, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if req.Method != http.MethodGet || resp.StatusCode != http.StatusOK || (len(etag) < 1 && len(lastModified) < 1) {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBodySize+1))
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if len(body) <= maxCachedBodySize {
+		rc.set(key, &cachedResponse{
+			etag:         etag,
+			lastModified: lastModified,
+			header:       resp.Header.Clone(),
+			body:         body,
+		})
+	}
+
+	return resp, nil
+}
+
+// ResponseExpires returns the time the supplied response should be considered out of date, using its Cache-Control
+// max-age directive, or failing that its Expires header. The zero time is returned if neither is set.
+func ResponseExpires(resp *http.Response, now time.Time) time.Time {
+	for _, directive := range strings.Split(resp.Header.Get("Cache-Control"), ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+
+		if directive == "no-cache" || directive == "no-store" {
+			return now
+		} else if strings.HasPrefix(directive, "max-age=") {
+			maxAge, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age="))
+			if err != nil {
+				continue
+			}
+
+			// The Age header is the time the response has already spent in intermediate caches.
+			age, _ := strconv.Atoi(resp.Header.Get("Age"))
+			return now.Add(time.Duration(maxAge-age) * time.Second)
+		}
+	}
+
+	value := resp.Header.Get("Expires")
+	if len(value) < 1 {
+		return time.Time{}
+	}
+
+	// Invalid values, such as "0", mean the response has already expired.
+	expires, err := http.ParseTime(value)
+	if err != nil {
+		return now
+	}
+
+	// Expires is relative to the upstream clock, which may not match ours.
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		return now.Add(expires.Sub(date))
+	}
+	return expires
+}
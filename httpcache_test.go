@@ -0,0 +1,118 @@
+package weather
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestHTTPClient_DoConditional(t *testing.T) {
+	var requests []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Header.Clone())
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.Header().Set("Cache-Control", "max-age=120")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Wed, 20 Nov 2024 19:00:00 GMT")
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.Write([]byte("feed body"))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(zap.NewNop(), HTTPConfig{})
+
+	get := func() (*http.Response, string) {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		assert.NoError(t, err)
+
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		return resp, string(body)
+	}
+
+	resp, body := get()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "feed body", body)
+	assert.Empty(t, requests[0].Get("If-None-Match"))
+
+	// The second request is conditional, and the unchanged body is returned from the cache
+	// with the freshness of the 304 response.
+	resp, body = get()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "feed body", body)
+	assert.Equal(t, `"v1"`, requests[1].Get("If-None-Match"))
+	assert.Equal(t, "Wed, 20 Nov 2024 19:00:00 GMT", requests[1].Get("If-Modified-Since"))
+	assert.Equal(t, "max-age=120", resp.Header.Get("Cache-Control"))
+	assert.Equal(t, `"v1"`, resp.Header.Get("ETag"))
+}
+
+func TestResponseExpires(t *testing.T) {
+	now := time.Date(2024, time.November, 20, 19, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		header  http.Header
+		expires time.Time
+	}{
+		{
+			name:    "max age",
+			header:  http.Header{"Cache-Control": {"public, max-age=300"}},
+			expires: now.Add(time.Minute * 5),
+		},
+		{
+			name:    "max age less the time spent in caches",
+			header:  http.Header{"Cache-Control": {"max-age=300"}, "Age": {"60"}},
+			expires: now.Add(time.Minute * 4),
+		},
+		{
+			name:    "max age takes precedence over expires",
+			header:  http.Header{"Cache-Control": {"max-age=300"}, "Expires": {"Wed, 20 Nov 2024 20:00:00 GMT"}},
+			expires: now.Add(time.Minute * 5),
+		},
+		{
+			name:    "no cache",
+			header:  http.Header{"Cache-Control": {"no-cache"}},
+			expires: now,
+		},
+		{
+			name:    "expires relative to the upstream clock",
+			header:  http.Header{"Expires": {"Wed, 20 Nov 2024 20:00:00 GMT"}, "Date": {"Wed, 20 Nov 2024 19:30:00 GMT"}},
+			expires: now.Add(time.Minute * 30),
+		},
+		{
+			name:    "expires",
+			header:  http.Header{"Expires": {"Wed, 20 Nov 2024 20:00:00 GMT"}},
+			expires: time.Date(2024, time.November, 20, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "invalid expires",
+			header:  http.Header{"Expires": {"0"}},
+			expires: now,
+		},
+		{
+			name:   "unknown",
+			header: http.Header{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expires := ResponseExpires(&http.Response{Header: tt.header}, now)
+			assert.True(t, tt.expires.Equal(expires), "expected %s, got %s", tt.expires, expires)
+		})
+	}
+}
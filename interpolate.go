@@ -0,0 +1,70 @@
+package weather
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// idwPower is the power the distance of each station is raised to when weighting it.
+	// Higher values favour the closest stations more strongly.
+	idwPower = 2
+	// idwMinDistance is the distance, in metres, within which a station is treated as being at the requested location.
+	idwMinDistance = 1
+)
+
+// idwWeights returns the inverse distance weights of stations at the supplied distances (in metres), which sum to 1.
+// A station at the requested location takes all of the weight.
+// See https://en.wikipedia.org/wiki/Inverse_distance_weighting
+func idwWeights(distances []float64) []float64 {
+	weights := make([]float64, len(distances))
+	for i, d := range distances {
+		if d < idwMinDistance {
+			weights[i] = 1
+			return weights
+		}
+	}
+
+	total := 0.0
+	for i, d := range distances {
+		weights[i] = 1 / math.Pow(d, idwPower)
+		total += weights[i]
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+	return weights
+}
+
+// interpolateConditions blends the numeric values of the supplied conditions using the supplied weights.
+// The other values are taken from the first conditions, which should be those of the closest station.
+// Pressure and humidity are never zero, so zero is taken to mean they weren't reported, and those conditions are
+// left out of the blended value.
+func interpolateConditions(conditions []*WeatherCondition, weights []float64) *WeatherCondition {
+	blend := func(value func(c *WeatherCondition) float64, reported func(v float64) bool) float64 {
+		var sum, total float64
+		for i, c := range conditions {
+			v := value(c)
+			if !reported(v) {
+				continue
+			}
+			sum += v * weights[i]
+			total += weights[i]
+		}
+		if total <= 0 {
+			return 0
+		}
+		return sum / total
+	}
+	always := func(v float64) bool { return true }
+	nonZero := func(v float64) bool { return v != 0 }
+
+	result := proto.Clone(conditions[0]).(*WeatherCondition)
+	result.Temperature = float32(blend(func(c *WeatherCondition) float64 { return float64(c.Temperature) }, always))
+	result.DewPoint = float32(blend(func(c *WeatherCondition) float64 { return float64(c.DewPoint) }, always))
+	result.Pressure = float32(blend(func(c *WeatherCondition) float64 { return float64(c.Pressure) }, nonZero))
+	result.WindSpeed = int32(math.Round(blend(func(c *WeatherCondition) float64 { return float64(c.WindSpeed) }, always)))
+	result.Humidity = int32(math.Round(blend(func(c *WeatherCondition) float64 { return float64(c.Humidity) }, nonZero)))
+	return result
+}
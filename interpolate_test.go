@@ -0,0 +1,83 @@
+package weather
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDWWeights(t *testing.T) {
+	tests := []struct {
+		name      string
+		distances []float64
+		weights   []float64
+	}{
+		{
+			name:      "single station",
+			distances: []float64{5000},
+			weights:   []float64{1},
+		},
+		{
+			name:      "twice as far",
+			distances: []float64{1000, 2000},
+			weights:   []float64{0.8, 0.2},
+		},
+		{
+			name:      "equal distances",
+			distances: []float64{3000, 3000, 3000, 3000},
+			weights:   []float64{0.25, 0.25, 0.25, 0.25},
+		},
+		{
+			name:      "at a station",
+			distances: []float64{1000, 0.5, 2000},
+			weights:   []float64{0, 1, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weights := idwWeights(tt.distances)
+			assert.Len(t, weights, len(tt.weights))
+			for i := range weights {
+				assert.InDelta(t, tt.weights[i], weights[i], 0.0001)
+			}
+		})
+	}
+}
+
+func TestInterpolateConditions(t *testing.T) {
+	conditions := []*WeatherCondition{
+		{
+			SummaryIcon: WeatherIcon_RAIN,
+			Summary:     "Light Rain",
+			Temperature: 10,
+			DewPoint:    8,
+			Humidity:    90,
+			Pressure:    100.5,
+			WindSpeed:   10,
+			Visibility:  12,
+		},
+		{
+			SummaryIcon: WeatherIcon_CLOUDY,
+			Summary:     "Cloudy",
+			Temperature: 15,
+			DewPoint:    5,
+			// Humidity and pressure weren't reported.
+			WindSpeed:  25,
+			Visibility: 24,
+		},
+	}
+
+	result := interpolateConditions(conditions, []float64{0.8, 0.2})
+	assert.Equal(t, WeatherIcon_RAIN, result.SummaryIcon)
+	assert.Equal(t, "Light Rain", result.Summary)
+	assert.Equal(t, int32(12), result.Visibility)
+	assert.InDelta(t, 11, result.Temperature, 0.001)
+	assert.InDelta(t, 7.4, result.DewPoint, 0.001)
+	assert.Equal(t, int32(90), result.Humidity)
+	assert.InDelta(t, 100.5, result.Pressure, 0.001)
+	assert.Equal(t, int32(13), result.WindSpeed)
+
+	// The conditions supplied aren't changed.
+	assert.Equal(t, float32(10), conditions[0].Temperature)
+}
@@ -0,0 +1,373 @@
+package weather
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// kdBalance is the largest fraction of a subtree which may be on one side of its root before the subtree is rebuilt.
+// Lower values keep the tree closer to balanced at the cost of rebuilding more often.
+const kdBalance = 0.7
+
+// kdNode is a single entry of a kdTree.
+type kdNode[T any] struct {
+	point [3]float64
+	entry entry[T]
+	// deleted nodes are skipped by searches, and dropped the next time the whole tree is rebuilt.
+	deleted bool
+
+	left  *kdNode[T]
+	right *kdNode[T]
+	// size is the number of nodes in the subtree rooted at this node, including deleted nodes.
+	size int
+}
+
+// kdTree is a k-d tree of locations, which are converted to points on the unit sphere so that the straight line
+// (chord) distance between them increases with the great-circle distance, without any special handling of the poles
+// or the antimeridian. Nodes are split on the x, y and z axes in turn.
+// It is kept balanced as entries are added by rebuilding any subtree which becomes too lopsided; see
+// https://en.wikipedia.org/wiki/Scapegoat_tree
+// Removed entries are only marked as deleted, until they make up half of the tree and it is rebuilt without them.
+type kdTree[T any] struct {
+	root    *kdNode[T]
+	deleted int
+}
+
+// toUnitVector converts the supplied latitude and longitude (in degrees) into a point on the unit sphere.
+func toUnitVector(lat float64, lon float64) [3]float64 {
+	la := lat * math.Pi / 180
+	lo := lon * math.Pi / 180
+	return [3]float64{
+		math.Cos(la) * math.Cos(lo),
+		math.Cos(la) * math.Sin(lo),
+		math.Sin(la),
+	}
+}
+
+// chordDistance returns the square of the straight line distance between the supplied points.
+func chordDistance(a [3]float64, b [3]float64) float64 {
+	dx := a[0] - b[0]
+	dy := a[1] - b[1]
+	dz := a[2] - b[2]
+	return dx*dx + dy*dy + dz*dz
+}
+
+// chordLength returns the straight line distance between two points on the unit sphere which are
+// the supplied great-circle distance (in metres) apart.
+func chordLength(meters float64) float64 {
+	angle := math.Min(meters/earthRadius, math.Pi)
+	return 2 * math.Sin(angle/2)
+}
+
+func (n *kdNode[T]) nodeSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// insert adds a node for the supplied entry to the tree, and returns it.
+func (t *kdTree[T]) insert(e entry[T]) *kdNode[T] {
+	node := &kdNode[T]{
+		point: toUnitVector(e.latitude, e.longitude),
+		entry: e,
+		size:  1,
+	}
+
+	if t.root == nil {
+		t.root = node
+		return node
+	}
+
+	var path []*kdNode[T]
+	link := &t.root
+	for depth := 0; *link != nil; depth++ {
+		parent := *link
+		parent.size++
+		path = append(path, parent)
+
+		if node.point[depth%3] < parent.point[depth%3] {
+			link = &parent.left
+		} else {
+			link = &parent.right
+		}
+	}
+	*link = node
+
+	// The tree is only rebalanced if the new node is deeper than a balanced tree of this size would be.
+	if float64(len(path)) <= math.Log(float64(t.root.size))/math.Log(1/kdBalance) {
+		return node
+	}
+
+	// Rebuild the lowest subtree along the path which is lopsided.
+	for depth := len(path) - 1; depth >= 0; depth-- {
+		candidate := path[depth]
+		if float64(max(candidate.left.nodeSize(), candidate.right.nodeSize())) <= kdBalance*float64(candidate.size) {
+			continue
+		}
+
+		rebuilt := buildKDTree(candidate.flatten(nil), depth)
+		if depth == 0 {
+			t.root = rebuilt
+		} else if parent := path[depth-1]; parent.left == candidate {
+			parent.left = rebuilt
+		} else {
+			parent.right = rebuilt
+		}
+		return node
+	}
+	return node
+}
+
+// remove marks the supplied node as deleted.
+func (t *kdTree[T]) remove(node *kdNode[T]) {
+	node.deleted = true
+	t.deleted++
+
+	if t.deleted*2 < t.root.size {
+		return
+	}
+
+	var live []*kdNode[T]
+	for _, n := range t.root.flatten(nil) {
+		if !n.deleted {
+			live = append(live, n)
+		}
+	}
+	t.root = buildKDTree(live, 0)
+	t.deleted = 0
+}
+
+// flatten appends every node in the subtree rooted at this node to the supplied slice.
+func (n *kdNode[T]) flatten(nodes []*kdNode[T]) []*kdNode[T] {
+	if n == nil {
+		return nodes
+	}
+	nodes = n.left.flatten(nodes)
+	nodes = append(nodes, n)
+	return n.right.flatten(nodes)
+}
+
+// buildKDTree builds a balanced subtree, to be placed at the supplied depth, from the supplied nodes.
+// Each subtree is split at the median of its nodes on the axis of its depth.
+func buildKDTree[T any](nodes []*kdNode[T], depth int) *kdNode[T] {
+	if len(nodes) < 1 {
+		return nil
+	}
+
+	axis := depth % 3
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].point[axis] < nodes[j].point[axis]
+	})
+
+	// Nodes equal to the median on the axis must be placed on the right, to match insert.
+	median := len(nodes) / 2
+	for median > 0 && nodes[median-1].point[axis] == nodes[median].point[axis] {
+		median--
+	}
+
+	root := nodes[median]
+	root.left = buildKDTree(nodes[:median], depth+1)
+	root.right = buildKDTree(nodes[median+1:], depth+1)
+	root.size = len(nodes)
+	return root
+}
+
+// kdCandidate is a node found by a search, along with the square of its chord distance from the searched point.
+type kdCandidate[T any] struct {
+	node     *kdNode[T]
+	distance float64
+}
+
+// kdCandidates is a max-heap of the closest nodes found so far, with the furthest at the top.
+type kdCandidates[T any] []kdCandidate[T]
+
+func (c kdCandidates[T]) Len() int           { return len(c) }
+func (c kdCandidates[T]) Less(i, j int) bool { return c[i].distance > c[j].distance }
+func (c kdCandidates[T]) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c *kdCandidates[T]) Push(x any)        { *c = append(*c, x.(kdCandidate[T])) }
+func (c *kdCandidates[T]) Pop() any {
+	old := *c
+	last := old[len(old)-1]
+	*c = old[:len(old)-1]
+	return last
+}
+
+// nearest returns the k nodes closest to the supplied point, closest first.
+func (t *kdTree[T]) nearest(point [3]float64, k int) []kdCandidate[T] {
+	if k < 1 {
+		return nil
+	}
+
+	candidates := &kdCandidates[T]{}
+	t.root.nearest(point, k, 0, candidates)
+
+	results := make([]kdCandidate[T], candidates.Len())
+	for i := len(results) - 1; i >= 0; i-- {
+		results[i] = heap.Pop(candidates).(kdCandidate[T])
+	}
+	return results
+}
+
+func (n *kdNode[T]) nearest(point [3]float64, k int, depth int, candidates *kdCandidates[T]) {
+	if n == nil {
+		return
+	}
+
+	if !n.deleted {
+		distance := chordDistance(point, n.point)
+		if candidates.Len() < k {
+			heap.Push(candidates, kdCandidate[T]{n, distance})
+		} else if distance < (*candidates)[0].distance {
+			(*candidates)[0] = kdCandidate[T]{n, distance}
+			heap.Fix(candidates, 0)
+		}
+	}
+
+	diff := point[depth%3] - n.point[depth%3]
+	near, far := n.left, n.right
+	if diff >= 0 {
+		near, far = far, near
+	}
+
+	// Search the side of the split the point is on first, and then the other side only if it could contain
+	// a node closer than those already found.
+	near.nearest(point, k, depth+1, candidates)
+	if candidates.Len() < k || diff*diff < (*candidates)[0].distance {
+		far.nearest(point, k, depth+1, candidates)
+	}
+}
+
+// within returns the nodes whose chord distance from the supplied point is at most radius, in no particular order.
+func (t *kdTree[T]) within(point [3]float64, radius float64) []kdCandidate[T] {
+	return t.root.within(point, radius*radius, 0, nil)
+}
+
+func (n *kdNode[T]) within(point [3]float64, radius2 float64, depth int, results []kdCandidate[T]) []kdCandidate[T] {
+	if n == nil {
+		return results
+	}
+
+	if distance := chordDistance(point, n.point); distance <= radius2 && !n.deleted {
+		results = append(results, kdCandidate[T]{n, distance})
+	}
+
+	diff := point[depth%3] - n.point[depth%3]
+	if diff < 0 || diff*diff <= radius2 {
+		results = n.left.within(point, radius2, depth+1, results)
+	}
+	if diff >= 0 || diff*diff <= radius2 {
+		results = n.right.within(point, radius2, depth+1, results)
+	}
+	return results
+}
+
+// kdQueueItem is a node, or the subtree rooted at a node, waiting to be visited by walk.
+// The distance of a subtree is a lower bound on the distance of any node in it, calculated from the offsets
+// on each axis between the point and the region of space the subtree covers.
+type kdQueueItem[T any] struct {
+	node     *kdNode[T]
+	subtree  bool
+	depth    int
+	offsets  [3]float64
+	distance float64
+}
+
+// kdQueue is a min-heap of the items waiting to be visited by walk, with the closest at the top.
+// It is used instead of container/heap as walk pushes many items, which would each be boxed in an interface.
+type kdQueue[T any] []kdQueueItem[T]
+
+func (q *kdQueue[T]) push(item kdQueueItem[T]) {
+	*q = append(*q, item)
+
+	items := *q
+	for i := len(items) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if items[parent].distance <= items[i].distance {
+			break
+		}
+		items[parent], items[i] = items[i], items[parent]
+		i = parent
+	}
+}
+
+func (q *kdQueue[T]) pop() kdQueueItem[T] {
+	items := *q
+	top := items[0]
+	last := len(items) - 1
+	items[0] = items[last]
+	items = items[:last]
+
+	for i := 0; ; {
+		smallest := i
+		if left := 2*i + 1; left < len(items) && items[left].distance < items[smallest].distance {
+			smallest = left
+		}
+		if right := 2*i + 2; right < len(items) && items[right].distance < items[smallest].distance {
+			smallest = right
+		}
+		if smallest == i {
+			break
+		}
+		items[i], items[smallest] = items[smallest], items[i]
+		i = smallest
+	}
+
+	*q = items
+	return top
+}
+
+// walk calls yield with each node of the tree in order of its distance from the supplied point, closest first,
+// until yield returns false. Subtrees are only searched once every node closer than them has been yielded,
+// so the cost of stopping early is similar to that of nearest.
+// See https://en.wikipedia.org/wiki/Nearest_neighbor_search#Exact_methods
+func (t *kdTree[T]) walk(point [3]float64, yield func(kdCandidate[T]) bool) {
+	if t.root == nil {
+		return
+	}
+
+	queue := make(kdQueue[T], 0, 64)
+	queue.push(kdQueueItem[T]{node: t.root, subtree: true})
+	for len(queue) > 0 {
+		item := queue.pop()
+
+		if !item.subtree {
+			if !yield(kdCandidate[T]{item.node, item.distance}) {
+				return
+			}
+			continue
+		}
+
+		// Descend to the leaf on the same side of each split as the point, queueing each node along the way and
+		// the subtrees on the other side of the splits.
+		offsets := item.offsets
+		bound := item.distance
+		for n, depth := item.node, item.depth; n != nil; depth++ {
+			if !n.deleted {
+				queue.push(kdQueueItem[T]{node: n, distance: chordDistance(point, n.point)})
+			}
+
+			axis := depth % 3
+			diff := point[axis] - n.point[axis]
+			near, far := n.left, n.right
+			if diff >= 0 {
+				near, far = far, near
+			}
+
+			// Nodes on the other side of the split are at least as far away on this axis as the split itself.
+			if far != nil {
+				farOffsets := offsets
+				farOffsets[axis] = diff
+				queue.push(kdQueueItem[T]{
+					node:     far,
+					subtree:  true,
+					depth:    depth + 1,
+					offsets:  farOffsets,
+					distance: bound - offsets[axis]*offsets[axis] + diff*diff,
+				})
+			}
+			n = near
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package noaa
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rmrobinson/weather"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	alertSeverities = map[string]weather.AlertSeverity{
+		"Minor":    weather.AlertSeverity_MINOR,
+		"Moderate": weather.AlertSeverity_MODERATE,
+		"Severe":   weather.AlertSeverity_SEVERE,
+		"Extreme":  weather.AlertSeverity_EXTREME,
+	}
+	alertUrgencies = map[string]weather.AlertUrgency{
+		"Immediate": weather.AlertUrgency_IMMEDIATE,
+		"Expected":  weather.AlertUrgency_EXPECTED,
+		"Future":    weather.AlertUrgency_FUTURE,
+		"Past":      weather.AlertUrgency_PAST,
+	}
+	alertCertainties = map[string]weather.AlertCertainty{
+		"Observed": weather.AlertCertainty_OBSERVED,
+		"Likely":   weather.AlertCertainty_LIKELY,
+		"Possible": weather.AlertCertainty_POSSIBLE,
+		"Unlikely": weather.AlertCertainty_UNLIKELY,
+	}
+)
+
+type alertGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+type alertProperties struct {
+	ID          string     `json:"id"`
+	AreaDesc    string     `json:"areaDesc"`
+	Sent        *time.Time `json:"sent"`
+	Effective   *time.Time `json:"effective"`
+	Expires     *time.Time `json:"expires"`
+	Ends        *time.Time `json:"ends"`
+	Severity    string     `json:"severity"`
+	Certainty   string     `json:"certainty"`
+	Urgency     string     `json:"urgency"`
+	Event       string     `json:"event"`
+	Headline    string     `json:"headline"`
+	Description string     `json:"description"`
+}
+
+type alertFeature struct {
+	ID         string          `json:"id"`
+	Geometry   *alertGeometry  `json:"geometry"`
+	Properties alertProperties `json:"properties"`
+}
+
+type alertCollection struct {
+	Type     string          `json:"type"`
+	Features []*alertFeature `json:"features"`
+}
+
+func (af *alertFeature) toAlert() *weather.WeatherAlert {
+	props := af.Properties
+
+	alert := &weather.WeatherAlert{
+		AlertId:     props.ID,
+		Severity:    alertSeverities[props.Severity],
+		Urgency:     alertUrgencies[props.Urgency],
+		Certainty:   alertCertainties[props.Certainty],
+		Event:       props.Event,
+		Headline:    props.Headline,
+		Description: props.Description,
+		Area:        props.AreaDesc,
+	}
+	if len(alert.AlertId) < 1 {
+		alert.AlertId = af.ID
+	}
+
+	polygons := af.polygons()
+	if len(polygons) > 0 {
+		alert.Polygon = polygons[0]
+	}
+	if len(polygons) > 1 {
+		for _, polygon := range polygons {
+			alert.Polygons = append(alert.Polygons, &weather.GeoPolygon{Points: polygon})
+		}
+	}
+
+	if props.Sent != nil {
+		alert.IssuedAt = timestamppb.New(*props.Sent)
+	}
+	if props.Effective != nil {
+		alert.EffectiveAt = timestamppb.New(*props.Effective)
+	}
+	// The end of the event is more useful than the expiry of the message, which is often re-issued.
+	if props.Ends != nil {
+		alert.ExpiresAt = timestamppb.New(*props.Ends)
+	} else if props.Expires != nil {
+		alert.ExpiresAt = timestamppb.New(*props.Expires)
+	}
+
+	return alert
+}
+
+// polygons returns the exterior ring of each polygon of the alert geometry, if the alert has one.
+// Alerts issued for forecast zones rather than a drawn area have no geometry.
+func (af *alertFeature) polygons() [][]*weather.GeoPoint {
+	if af.Geometry == nil {
+		return nil
+	}
+
+	// GeoJSON polygons are a list of rings, each a list of [lon, lat] positions, and multipolygons a list of polygons.
+	var polygons [][][][]float64
+	switch af.Geometry.Type {
+	case "Polygon":
+		var rings [][][]float64
+		err := json.Unmarshal(af.Geometry.Coordinates, &rings)
+		if err != nil {
+			return nil
+		}
+		polygons = append(polygons, rings)
+	case "MultiPolygon":
+		err := json.Unmarshal(af.Geometry.Coordinates, &polygons)
+		if err != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	var results [][]*weather.GeoPoint
+	for _, rings := range polygons {
+		if len(rings) < 1 {
+			continue
+		}
+
+		var points []*weather.GeoPoint
+		for _, position := range rings[0] {
+			if len(position) < 2 {
+				continue
+			}
+
+			points = append(points, &weather.GeoPoint{
+				Latitude:  position[1],
+				Longitude: position[0],
+			})
+		}
+		results = append(results, points)
+	}
+
+	return results
+}
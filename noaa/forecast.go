@@ -0,0 +1,253 @@
+package noaa
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rmrobinson/weather"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// forecastPeriod is a single day or night period of the forecast, as defined by the max and min temperature intervals.
+type forecastPeriod struct {
+	interval
+
+	validTime   string
+	temperature *float64
+	day         bool
+}
+
+type weatherValue struct {
+	Coverage  *string `json:"coverage"`
+	Weather   *string `json:"weather"`
+	Intensity *string `json:"intensity"`
+}
+
+type propertyValueWeather struct {
+	ValidTime string         `json:"validTime"`
+	Value     []weatherValue `json:"value"`
+}
+
+type propertyWeather struct {
+	Values []propertyValueWeather `json:"values"`
+}
+
+func (f *feature) parseForecast() []*weather.WeatherForecast {
+	var updatedAt *timestamppb.Timestamp
+	if prop, ok := f.Properties["updateTime"]; ok {
+		var updateTime time.Time
+		if err := json.Unmarshal(*prop, &updateTime); err == nil {
+			updatedAt = timestamppb.New(updateTime)
+		}
+	}
+
+	var periods []*forecastPeriod
+	periods = append(periods, f.getPeriodsFromProperty("maxTemperature", true)...)
+	periods = append(periods, f.getPeriodsFromProperty("minTemperature", false)...)
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].start.Before(periods[j].start)
+	})
+
+	temperature := f.getTimeSeries("temperature", unitCelsius)
+	precipitationChance := f.getTimeSeries("probabilityOfPrecipitation", unitPercent)
+	windSpeed := f.getTimeSeries("windSpeed", unitKilometresPerHour)
+	skyCover := f.getTimeSeries("skyCover", unitPercent)
+	conditions := f.getWeatherProperty()
+
+	var forecasts []*weather.WeatherForecast
+	for _, period := range periods {
+		cond := &weather.WeatherCondition{}
+
+		// If the extreme for the period is unknown, fall back to the most extreme hourly temperature.
+		if period.temperature != nil {
+			cond.Temperature = float32(*period.temperature)
+		} else if period.day {
+			if val, ok := temperature.max(period.start, period.end); ok {
+				cond.Temperature = float32(val)
+			}
+		} else if val, ok := temperature.min(period.start, period.end); ok {
+			cond.Temperature = float32(val)
+		}
+
+		if val, ok := precipitationChance.max(period.start, period.end); ok {
+			cond.PrecipitationChance = int32(val)
+		}
+		if val, ok := windSpeed.max(period.start, period.end); ok {
+			cond.WindSpeed = int32(val)
+		}
+
+		cover, hasCover := skyCover.mean(period.start, period.end)
+		wv := conditions.first(period.interval)
+		cond.Summary, cond.SummaryIcon = describeConditions(wv, cover, hasCover, cond.PrecipitationChance, period.day)
+
+		forecast := &weather.WeatherForecast{
+			ForecastedFor: timestamppb.New(period.start),
+			ForecastId:    fmt.Sprintf("%v/%s", f.ID, period.validTime),
+			CreatedAt:     updatedAt,
+			UpdatedAt:     updatedAt,
+			Conditions:    cond,
+		}
+		forecasts = append(forecasts, forecast)
+	}
+
+	return forecasts
+}
+
+func (f *feature) getPeriodsFromProperty(propName string, day bool) []*forecastPeriod {
+	ts := f.getTimeSeries(propName, unitCelsius)
+	if ts == nil {
+		return nil
+	}
+
+	var periods []*forecastPeriod
+	for _, value := range ts.values {
+		periods = append(periods, &forecastPeriod{
+			interval:    value.interval,
+			validTime:   value.validTime,
+			temperature: value.value,
+			day:         day,
+		})
+	}
+
+	return periods
+}
+
+// getTimeSeries returns the named gridpoint property as a time series, converted into the supplied unit.
+// Nil is returned if the property is missing, malformed or in a unit which can't be converted.
+func (f *feature) getTimeSeries(propName string, to string) *timeSeries {
+	prop, ok := f.Properties[propName]
+	if !ok || prop == nil {
+		return nil
+	}
+
+	ts, err := parseTimeSeries(*prop, to)
+	if err != nil {
+		f.logger.Info("error unmarshaling property",
+			zap.String("property", propName),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	return ts
+}
+
+func (f *feature) getWeatherProperty() *propertyWeather {
+	prop, ok := f.Properties["weather"]
+	if !ok || prop == nil {
+		return nil
+	}
+
+	property := &propertyWeather{}
+	err := json.Unmarshal(*prop, property)
+	if err != nil {
+		f.logger.Info("error unmarshaling property",
+			zap.String("property", "weather"),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	return property
+}
+
+// first returns the first set of weather conditions overlapping the supplied interval.
+// Values without any weather, which are sent when none is forecast, are skipped.
+func (p *propertyWeather) first(i interval) *weatherValue {
+	if p == nil {
+		return nil
+	}
+
+	for _, value := range p.Values {
+		validFor, err := parseValidTime(value.ValidTime)
+		if err != nil || !validFor.overlaps(i.start, i.end) {
+			continue
+		}
+
+		for _, wv := range value.Value {
+			if wv.Weather != nil && len(*wv.Weather) > 0 {
+				return &wv
+			}
+		}
+	}
+
+	return nil
+}
+
+// describeConditions creates a summary and icon from the forecasted weather, falling back to the sky cover if no
+// weather is forecast.
+func describeConditions(wv *weatherValue, skyCover float64, hasSkyCover bool, precipitationChance int32, day bool) (string, weather.WeatherIcon) {
+	if wv != nil {
+		desc := strings.ReplaceAll(*wv.Weather, "_", " ")
+		if wv.Intensity != nil && len(*wv.Intensity) > 0 {
+			desc = strings.ReplaceAll(*wv.Intensity, "_", " ") + " " + desc
+		}
+
+		likely := true
+		if wv.Coverage != nil {
+			switch *wv.Coverage {
+			case "slight_chance", "chance":
+				likely = false
+				desc = strings.ReplaceAll(*wv.Coverage, "_", " ") + " of " + desc
+			case "likely":
+				desc = desc + " likely"
+			}
+		}
+		if precipitationChance > 0 && precipitationChance < 50 {
+			likely = false
+		}
+
+		if len(desc) > 0 {
+			desc = strings.ToUpper(desc[:1]) + desc[1:]
+		}
+		return desc, iconFromWeather(*wv.Weather, likely)
+	}
+
+	if !hasSkyCover {
+		return "", weather.WeatherIcon_SUNNY
+	}
+
+	clearText := "Sunny"
+	if !day {
+		clearText = "Clear"
+	}
+
+	switch {
+	case skyCover < 25:
+		return clearText, weather.WeatherIcon_SUNNY
+	case skyCover < 50:
+		return "Partly cloudy", weather.WeatherIcon_PARTIALLY_CLOUDY
+	case skyCover < 85:
+		return "Mostly cloudy", weather.WeatherIcon_MOSTLY_CLOUDY
+	}
+	return "Cloudy", weather.WeatherIcon_CLOUDY
+}
+
+func iconFromWeather(wx string, likely bool) weather.WeatherIcon {
+	switch {
+	case strings.Contains(wx, "thunderstorms"):
+		return weather.WeatherIcon_THUNDERSTORMS
+	case strings.Contains(wx, "snow_showers"):
+		if !likely {
+			return weather.WeatherIcon_CHANCE_OF_SNOW
+		}
+		return weather.WeatherIcon_SNOW_SHOWERS
+	case strings.Contains(wx, "snow"), strings.Contains(wx, "sleet"), strings.Contains(wx, "freezing"):
+		if !likely {
+			return weather.WeatherIcon_CHANCE_OF_SNOW
+		}
+		return weather.WeatherIcon_SNOW
+	case strings.Contains(wx, "rain"), strings.Contains(wx, "drizzle"):
+		if !likely {
+			return weather.WeatherIcon_CHANCE_OF_RAIN
+		}
+		return weather.WeatherIcon_RAIN
+	case strings.Contains(wx, "fog"), strings.Contains(wx, "haze"), strings.Contains(wx, "smoke"):
+		return weather.WeatherIcon_FOG
+	}
+	return weather.WeatherIcon_CLOUDY
+}
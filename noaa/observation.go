@@ -0,0 +1,185 @@
+package noaa
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rmrobinson/weather"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type observationValue struct {
+	UnitCode string   `json:"unitCode"`
+	Value    *float64 `json:"value"`
+}
+
+type observationProperties struct {
+	Timestamp          time.Time         `json:"timestamp"`
+	TextDescription    string            `json:"textDescription"`
+	Icon               string            `json:"icon"`
+	Temperature        *observationValue `json:"temperature"`
+	Dewpoint           *observationValue `json:"dewpoint"`
+	WindSpeed          *observationValue `json:"windSpeed"`
+	BarometricPressure *observationValue `json:"barometricPressure"`
+	Visibility         *observationValue `json:"visibility"`
+	RelativeHumidity   *observationValue `json:"relativeHumidity"`
+	WindChill          *observationValue `json:"windChill"`
+	HeatIndex          *observationValue `json:"heatIndex"`
+	// PrecipitationLastHour is the precipitation in the hour before the observation.
+	PrecipitationLastHour *observationValue `json:"precipitationLastHour"`
+}
+
+type observation struct {
+	ID         string                `json:"id"`
+	Type       string                `json:"type"`
+	Properties observationProperties `json:"properties"`
+}
+
+// iconCodes maps the condition codes used in NWS icon URLs onto our icons.
+// See https://api.weather.gov/icons
+var iconCodes = map[string]weather.WeatherIcon{
+	"skc":             weather.WeatherIcon_SUNNY,
+	"few":             weather.WeatherIcon_SUNNY,
+	"hot":             weather.WeatherIcon_SUNNY,
+	"cold":            weather.WeatherIcon_SUNNY,
+	"wind_skc":        weather.WeatherIcon_SUNNY,
+	"wind_few":        weather.WeatherIcon_SUNNY,
+	"sct":             weather.WeatherIcon_PARTIALLY_CLOUDY,
+	"wind_sct":        weather.WeatherIcon_PARTIALLY_CLOUDY,
+	"bkn":             weather.WeatherIcon_MOSTLY_CLOUDY,
+	"wind_bkn":        weather.WeatherIcon_MOSTLY_CLOUDY,
+	"ovc":             weather.WeatherIcon_CLOUDY,
+	"wind_ovc":        weather.WeatherIcon_CLOUDY,
+	"rain":            weather.WeatherIcon_RAIN,
+	"rain_showers":    weather.WeatherIcon_RAIN,
+	"rain_showers_hi": weather.WeatherIcon_CHANCE_OF_RAIN,
+	"snow":            weather.WeatherIcon_SNOW,
+	"blizzard":        weather.WeatherIcon_SNOW,
+	"sleet":           weather.WeatherIcon_SNOW,
+	"fzra":            weather.WeatherIcon_SNOW,
+	"rain_snow":       weather.WeatherIcon_SNOW,
+	"rain_sleet":      weather.WeatherIcon_SNOW,
+	"rain_fzra":       weather.WeatherIcon_SNOW,
+	"snow_sleet":      weather.WeatherIcon_SNOW,
+	"snow_fzra":       weather.WeatherIcon_SNOW,
+	"tsra":            weather.WeatherIcon_THUNDERSTORMS,
+	"tsra_sct":        weather.WeatherIcon_THUNDERSTORMS,
+	"tsra_hi":         weather.WeatherIcon_THUNDERSTORMS,
+	"tornado":         weather.WeatherIcon_THUNDERSTORMS,
+	"hurricane":       weather.WeatherIcon_THUNDERSTORMS,
+	"tropical_storm":  weather.WeatherIcon_THUNDERSTORMS,
+	"fog":             weather.WeatherIcon_FOG,
+	"haze":            weather.WeatherIcon_FOG,
+	"smoke":           weather.WeatherIcon_FOG,
+	"dust":            weather.WeatherIcon_FOG,
+}
+
+// getObservation retrieves the latest observation made by the supplied observation station.
+func (s *Station) getObservation(ctx context.Context, stationID string) (*observation, error) {
+	obsURL := fmt.Sprintf("%s/stations/%s/observations/latest", s.baseURL, url.PathEscape(stationID))
+
+	obs := &observation{}
+	err := s.getJSON(ctx, obsURL, obs)
+	if err != nil {
+		return nil, err
+	}
+
+	return obs, nil
+}
+
+func (s *Station) parseObservation(obs *observation) *weather.WeatherReport {
+	props := obs.Properties
+
+	cond := &weather.WeatherCondition{
+		Summary:     props.TextDescription,
+		SummaryIcon: iconFromURL(props.Icon),
+	}
+	if val, ok := s.observationValue("temperature", props.Temperature, unitCelsius); ok {
+		cond.Temperature = float32(val)
+	}
+	if val, ok := s.observationValue("dewpoint", props.Dewpoint, unitCelsius); ok {
+		cond.DewPoint = float32(val)
+	}
+	if val, ok := s.observationValue("windChill", props.WindChill, unitCelsius); ok {
+		cond.WindChill = float32(val)
+	}
+	if val, ok := s.observationValue("heatIndex", props.HeatIndex, unitCelsius); ok {
+		cond.HeatIndex = float32(val)
+	}
+	if val, ok := s.observationValue("relativeHumidity", props.RelativeHumidity, unitPercent); ok {
+		cond.Humidity = int32(val)
+	}
+	if val, ok := s.observationValue("barometricPressure", props.BarometricPressure, unitKilopascals); ok {
+		cond.Pressure = float32(val)
+	}
+	if val, ok := s.observationValue("windSpeed", props.WindSpeed, unitKilometresPerHour); ok {
+		cond.WindSpeed = int32(val)
+	}
+	if val, ok := s.observationValue("visibility", props.Visibility, unitKilometres); ok {
+		cond.Visibility = int32(val)
+	}
+	if val, ok := s.observationValue("precipitationLastHour", props.PrecipitationLastHour, unitMillimetres); ok {
+		cond.Precipitation = float32(val)
+	}
+
+	observedAt := timestamppb.New(props.Timestamp)
+	return &weather.WeatherReport{
+		ObservedAt:    observedAt,
+		ObservationId: obs.ID,
+		CreatedAt:     observedAt,
+		UpdatedAt:     observedAt,
+		Conditions:    cond,
+	}
+}
+
+// observationValue converts the supplied value into the requested unit.
+// False is returned if the value is missing, or can't be converted.
+func (s *Station) observationValue(name string, ov *observationValue, to string) (float64, bool) {
+	if ov == nil || ov.Value == nil {
+		return 0, false
+	}
+
+	val, err := convert(*ov.Value, ov.UnitCode, to)
+	if err != nil {
+		s.logger.Info("error converting observation value",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return 0, false
+	}
+
+	return val, true
+}
+
+// iconFromURL returns the icon for an NWS icon URL of the form
+// "https://api.weather.gov/icons/land/day/rain_showers,40/bkn?size=medium".
+// When there are multiple conditions the first is used.
+func iconFromURL(iconURL string) weather.WeatherIcon {
+	u, err := url.Parse(iconURL)
+	if err != nil {
+		return weather.WeatherIcon_SUNNY
+	}
+
+	segments := strings.Split(strings.Trim(path.Clean(u.Path), "/"), "/")
+	for idx, segment := range segments {
+		if segment != "day" && segment != "night" {
+			continue
+		}
+		if idx+1 >= len(segments) {
+			break
+		}
+
+		code := strings.Split(segments[idx+1], ",")[0]
+		if icon, ok := iconCodes[code]; ok {
+			return icon
+		}
+		break
+	}
+
+	return weather.WeatherIcon_SUNNY
+}
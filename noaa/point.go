@@ -0,0 +1,102 @@
+package noaa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrPointNotFound is returned if NOAA doesn't provide forecasts for the requested latitude and longitude.
+var ErrPointNotFound = errors.New("point not found")
+
+// point contains the NWS metadata describing a single latitude and longitude.
+type point struct {
+	gridpointURL         string
+	forecastZone         string
+	timeZone             string
+	observationStationID string
+}
+
+type pointProperties struct {
+	ForecastGridData    string `json:"forecastGridData"`
+	ForecastZone        string `json:"forecastZone"`
+	ObservationStations string `json:"observationStations"`
+	TimeZone            string `json:"timeZone"`
+}
+
+type pointResponse struct {
+	Type       string          `json:"type"`
+	Properties pointProperties `json:"properties"`
+}
+
+type observationStationProperties struct {
+	StationIdentifier string `json:"stationIdentifier"`
+	Name              string `json:"name"`
+}
+
+type observationStationCollection struct {
+	Features []struct {
+		Properties observationStationProperties `json:"properties"`
+	} `json:"features"`
+}
+
+// resolvePoint retrieves the gridpoint, forecast zone and nearest observation station for this station's location.
+// The result is cached as the mapping of a location onto the NWS grid rarely changes.
+func (s *Station) resolvePoint(ctx context.Context) (*point, error) {
+	if s.point != nil {
+		return s.point, nil
+	}
+
+	pointURL := fmt.Sprintf("%s/points/%.4f,%.4f", s.baseURL, s.latitude, s.longitude)
+	pr := &pointResponse{}
+	err := s.getJSON(ctx, pointURL, pr)
+	if err != nil {
+		return nil, err
+	} else if len(pr.Properties.ForecastGridData) < 1 {
+		return nil, ErrPointNotFound
+	}
+
+	p := &point{
+		gridpointURL: pr.Properties.ForecastGridData,
+		forecastZone: pr.Properties.ForecastZone,
+		timeZone:     pr.Properties.TimeZone,
+	}
+
+	// The observation stations are sorted by their distance from the point, so the first is the nearest.
+	if len(pr.Properties.ObservationStations) > 0 {
+		stations := &observationStationCollection{}
+		err = s.getJSON(ctx, pr.Properties.ObservationStations, stations)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(stations.Features) > 0 {
+			p.observationStationID = stations.Features[0].Properties.StationIdentifier
+		}
+	}
+
+	s.logger.Debug("resolved point",
+		zap.String("station_title", s.title),
+		zap.String("gridpoint_url", p.gridpointURL),
+		zap.String("forecast_zone", p.forecastZone),
+		zap.String("observation_station_id", p.observationStationID),
+	)
+
+	if len(p.timeZone) > 0 {
+		loc, err := time.LoadLocation(p.timeZone)
+		if err != nil {
+			s.logger.Warn("error loading point time zone",
+				zap.String("time_zone", p.timeZone),
+				zap.Error(err),
+			)
+		} else {
+			s.timeZone.CompareAndSwap(nil, loc)
+		}
+	}
+
+	s.point = p
+	return p, nil
+}
@@ -0,0 +1,49 @@
+package noaa
+
+import (
+	"fmt"
+
+	"github.com/rmrobinson/weather"
+	"go.uber.org/zap"
+)
+
+// ProviderName is the name NOAA stations are registered with.
+const ProviderName = "noaa"
+
+func init() {
+	weather.RegisterProvider(ProviderName, weather.Provider{
+		NewStation: newStationFromConfig,
+		HTTPConfig: DefaultHTTPConfig(),
+	})
+}
+
+// newStationFromConfig creates a station from the "name", "latitude" and "longitude" config values.
+// If "url" is set it is used as the gridpoint URL, otherwise the gridpoint is looked up from the location.
+// If "time_zone" is set it overrides the time zone of the gridpoint.
+func newStationFromConfig(logger *zap.Logger, client *weather.HTTPClient, config weather.StationConfig) (weather.Station, error) {
+	name := config.String("name")
+	if len(name) < 1 {
+		return nil, fmt.Errorf("%w: name", weather.ErrMissingConfig)
+	}
+
+	lat, lon, err := config.Coordinates()
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := config.TimeZone()
+	if err != nil {
+		return nil, err
+	}
+
+	var s *Station
+	if url := config.String("url"); len(url) > 0 {
+		s = NewStation(logger, client, url, name, lat, lon)
+	} else {
+		s = NewStationAt(logger, client, name, lat, lon)
+	}
+	if loc != nil {
+		s.SetTimeZone(loc)
+	}
+	return s, nil
+}
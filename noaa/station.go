@@ -3,7 +3,11 @@ package noaa
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"sync/atomic"
 	"time"
 
 	"github.com/rmrobinson/weather"
@@ -11,32 +15,88 @@ import (
 )
 
 const (
-	refreshFrequency = time.Minute * 30
+	defaultBaseURL = "https://api.weather.gov"
 )
 
+var errUnexpectedType = errors.New("unexpected type")
+
 // Station represents a NOAA station location
 type Station struct {
-	url   string
-	title string
+	*weather.StationCache
+
+	url     string
+	baseURL string
+	title   string
 
 	latitude  float64
 	longitude float64
 
 	logger *zap.Logger
+	client *weather.HTTPClient
+
+	point *point
+
+	// timeZone is set from the point once it is resolved, unless it was already set.
+	// It is read by requests while the station is being refreshed.
+	timeZone atomic.Pointer[time.Location]
 
-	currentReport *weather.WeatherReport
-	forecast      []*weather.WeatherForecast
-	lastRefreshed time.Time
+	// expires is the earliest time any of the responses retrieved during the current refresh will be out of date.
+	// It is only used by fetch, which is never called concurrently.
+	expires time.Time
+}
+
+// NewStation creates a new station using the supplied gridpoint URL.
+// Alerts are retrieved from the same API host as the supplied gridpoint URL.
+// If client is nil, requests are made using the default HTTP configuration.
+func NewStation(logger *zap.Logger, client *weather.HTTPClient, gridpointURL string, title string, latitude float64, longitude float64) *Station {
+	if client == nil {
+		client = weather.NewHTTPClient(logger, DefaultHTTPConfig())
+	}
+
+	baseURL := defaultBaseURL
+	if u, err := url.Parse(gridpointURL); err == nil {
+		baseURL = fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	}
+
+	s := &Station{
+		url:       gridpointURL,
+		baseURL:   baseURL,
+		title:     title,
+		latitude:  latitude,
+		longitude: longitude,
+		logger:    logger,
+		client:    client,
+	}
+	s.StationCache = weather.NewStationCache(s.fetch)
+	return s
 }
 
-// NewStation creates a new station.
-func NewStation(logger *zap.Logger, url string, title string, latitude float64, longitude float64) *Station {
-	return &Station{
-		url:       url,
+// NewStationAt creates a new station for the supplied latitude and longitude.
+// The gridpoint covering the location is looked up the first time the station is refreshed.
+// If client is nil, requests are made using the default HTTP configuration.
+func NewStationAt(logger *zap.Logger, client *weather.HTTPClient, title string, latitude float64, longitude float64) *Station {
+	if client == nil {
+		client = weather.NewHTTPClient(logger, DefaultHTTPConfig())
+	}
+
+	s := &Station{
+		baseURL:   defaultBaseURL,
 		title:     title,
 		latitude:  latitude,
 		longitude: longitude,
 		logger:    logger,
+		client:    client,
+	}
+	s.StationCache = weather.NewStationCache(s.fetch)
+	return s
+}
+
+// DefaultHTTPConfig returns the HTTP configuration expected by api.weather.gov.
+// Callers should set a user agent which identifies their application and a contact.
+func DefaultHTTPConfig() weather.HTTPConfig {
+	return weather.HTTPConfig{
+		UserAgent: weather.DefaultUserAgent,
+		Accept:    "application/geo+json",
 	}
 }
 
@@ -55,189 +115,234 @@ func (s *Station) Longitude() float64 {
 	return s.longitude
 }
 
-// GetReport returns the current weather report for this station.
-func (s *Station) GetReport(ctx context.Context) (*weather.WeatherReport, error) {
-	if s.shouldRefresh() {
-		err := s.refresh(ctx)
-		if err != nil {
-			return nil, err
-		}
-	}
+// SetTimeZone sets the time zone of this weather station, instead of using the time zone of its gridpoint.
+func (s *Station) SetTimeZone(loc *time.Location) {
+	s.timeZone.Store(loc)
+}
 
-	return s.currentReport, nil
+// TimeZone returns the time zone of this weather station.
+// Nil is returned if it hasn't been set and the gridpoint hasn't been resolved yet.
+func (s *Station) TimeZone() *time.Location {
+	return s.timeZone.Load()
 }
 
-// GetForecast returns the forecast for this station
-func (s *Station) GetForecast(ctx context.Context) ([]*weather.WeatherForecast, error) {
-	if s.shouldRefresh() {
-		err := s.refresh(ctx)
-		if err != nil {
+// fetch retrieves the latest report, forecast and alerts for this station.
+// The observation and the gridpoint are retrieved separately, so that an outage of one doesn't lose the data of the
+// other; whichever can't be retrieved is carried over from the previous refresh, along with the time of that refresh,
+// and the data is marked as stale.
+// The previous alerts are kept if the latest can't be retrieved.
+func (s *Station) fetch(ctx context.Context, previous *weather.StationData) (*weather.StationData, error) {
+	s.expires = time.Time{}
+
+	// The point provides the nearest observation station, as well as the gridpoint if one wasn't supplied.
+	p, err := s.resolvePoint(ctx)
+	if err != nil {
+		s.logger.Warn("error resolving point",
+			zap.Error(err),
+		)
+		if len(s.url) < 1 {
 			return nil, err
 		}
+	} else if len(s.url) < 1 {
+		s.url = p.gridpointURL
 	}
 
-	return s.forecast, nil
-}
+	stale := false
+	// The data is only as recent as its oldest part, so that carried over parts still expire.
+	refreshedAt := time.Now()
+
+	// The gridpoint conditions are modelled, so what was actually observed nearby is reported instead when there is
+	// an observation station to use.
+	var report *weather.WeatherReport
+	var reportErr error
+	observed := p != nil && len(p.observationStationID) > 0
+	if observed {
+		var obs *observation
+		obs, reportErr = s.getObservation(ctx, p.observationStationID)
+		if reportErr != nil {
+			s.logger.Warn("error getting observation",
+				zap.String("observation_station_id", p.observationStationID),
+				zap.Error(reportErr),
+			)
+		} else {
+			report = s.parseObservation(obs)
+		}
+	}
 
-func (s *Station) shouldRefresh() bool {
-	return time.Now().Add(refreshFrequency * -1).After(s.lastRefreshed)
-}
+	gridReport, forecast, gridErr := s.getGridpoint(ctx)
+	if !observed {
+		report, reportErr = gridReport, gridErr
+	}
 
-func (s *Station) refresh(ctx context.Context) error {
-	feature, err := s.getFeature(ctx)
-	if err != nil {
-		s.logger.Warn("error getting feature",
-			zap.Error(err),
-		)
-		return err
+	// Nothing was retrieved, so the cached data is left unchanged.
+	if gridErr != nil && !observed {
+		return nil, gridErr
+	} else if gridErr != nil && reportErr != nil {
+		return nil, errors.Join(reportErr, gridErr)
 	}
 
-	report, forecast, err := s.parseFeature(feature)
+	if gridErr != nil {
+		if previous != nil {
+			forecast = previous.Forecast
+			refreshedAt = previous.RefreshedAt
+		}
+		stale = true
+	}
+	if reportErr != nil {
+		// Falling back to the modelled conditions would look like a different observation, so the previous
+		// observation is kept instead.
+		if previous != nil {
+			report = previous.Report
+			refreshedAt = previous.RefreshedAt
+		}
+		stale = true
+	}
+
+	// Alerts are supplementary to the report, so a failure to retrieve them shouldn't fail the refresh.
+	alerts, err := s.getAlerts(ctx)
 	if err != nil {
-		s.logger.Warn("error parsing feature",
+		s.logger.Warn("error getting alerts",
 			zap.Error(err),
 		)
-		return err
+		if previous != nil {
+			alerts = previous.Alerts
+		}
 	}
 
-	s.currentReport = report
-	s.forecast = forecast
-	s.lastRefreshed = time.Now()
-
 	s.logger.Debug("refreshed station",
 		zap.String("station_title", s.title),
+		zap.Bool("stale", stale),
 	)
 
-	return nil
+	return &weather.StationData{
+		Report:      report,
+		Forecast:    forecast,
+		Alerts:      alerts,
+		RefreshedAt: refreshedAt,
+		Expires:     s.expires,
+		Stale:       stale,
+	}, nil
 }
 
-func (s *Station) getFeature(ctx context.Context) (*feature, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+// getGridpoint retrieves the modelled conditions and the forecast from the gridpoint.
+func (s *Station) getGridpoint(ctx context.Context) (*weather.WeatherReport, []*weather.WeatherForecast, error) {
+	feature, err := s.getFeature(ctx)
 	if err != nil {
-		s.logger.Warn("error creating new request",
+		s.logger.Warn("error getting feature",
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, nil, err
 	}
 
-	client := http.Client{}
-	resp, err := client.Do(req)
+	report, forecast, err := s.parseFeature(feature, time.Now())
 	if err != nil {
-		s.logger.Warn("error performing request",
+		s.logger.Warn("error parsing feature",
 			zap.Error(err),
 		)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		s.logger.Info("received non-OK response",
-			zap.Int("status_code", resp.StatusCode),
-		)
-		return nil, nil
+		return nil, nil, err
 	}
+	return report, forecast, nil
+}
 
+func (s *Station) getFeature(ctx context.Context) (*feature, error) {
 	feature := &feature{
 		logger: s.logger,
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(feature)
+	err := s.getJSON(ctx, s.url, feature)
 	if err != nil {
-		s.logger.Info("error unmarshaling feature",
-			zap.Error(err),
-		)
 		return nil, err
 	} else if feature.Type != "Feature" {
 		s.logger.Info("unknown type detected",
 			zap.String("type", feature.Type),
 		)
-		return nil, nil
+		return nil, fmt.Errorf("%w: %q", errUnexpectedType, feature.Type)
 	}
 
 	return feature, nil
 }
 
-func (s *Station) parseFeature(f *feature) (*weather.WeatherReport, []*weather.WeatherForecast, error) {
-	windSpeed := f.getCurrentFloatFromProperty("windSpeed")
+func (s *Station) getAlerts(ctx context.Context) ([]*weather.WeatherAlert, error) {
+	alertsURL := fmt.Sprintf("%s/alerts/active?point=%.4f,%.4f", s.baseURL, s.latitude, s.longitude)
 
-	report := &weather.WeatherReport{
-		Conditions: &weather.WeatherCondition{
-			Temperature: f.getCurrentFloatFromProperty("temperature"),
-			DewPoint:    f.getCurrentFloatFromProperty("dewpoint"),
-			Humidity:    f.getCurrentIntFromProperty("relativeHumidity"),
-			WindSpeed:   int32(windSpeed),
-		},
+	collection := &alertCollection{}
+	err := s.getJSON(ctx, alertsURL, collection)
+	if err != nil {
+		return nil, err
 	}
-	var forecasts []*weather.WeatherForecast
 
-	return report, forecasts, nil
-}
-
-func (f *feature) getCurrentFloatFromProperty(propName string) float32 {
-	prop, ok := f.Properties[propName]
-	if !ok {
-		f.logger.Info("error, property is unset")
-		return 0
+	var alerts []*weather.WeatherAlert
+	for _, feature := range collection.Features {
+		alerts = append(alerts, feature.toAlert())
 	}
 
-	property := &propertyFloat{}
-	err := json.Unmarshal(*prop, property)
+	return alerts, nil
+}
+
+func (s *Station) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		f.logger.Info("error unmarshaling property",
+		s.logger.Warn("error creating new request",
 			zap.Error(err),
 		)
-		return 0
+		return err
 	}
 
-	val := property.Values[0].Value
-	if property.UnitOfMeasure == "unit:degF" {
-		val = (val - 32) * 5 / 9
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.logger.Warn("error performing request",
+			zap.Error(err),
+		)
+		return err
 	}
+	defer resp.Body.Close()
 
-	return float32(val)
-}
-
-func (f *feature) getCurrentIntFromProperty(propName string) int32 {
-	prop, ok := f.Properties[propName]
-	if !ok {
-		f.logger.Info("error, property is unset")
-		return 0
+	if resp.StatusCode != http.StatusOK {
+		s.logger.Info("received non-OK response",
+			zap.String("url", url),
+			zap.Int("status_code", resp.StatusCode),
+		)
+		return fmt.Errorf("%w %d", weather.ErrUnexpectedStatusCode, resp.StatusCode)
 	}
 
-	property := &propertyInt{}
-	err := json.Unmarshal(*prop, property)
+	err = json.NewDecoder(resp.Body).Decode(v)
 	if err != nil {
-		f.logger.Info("error unmarshaling property",
+		s.logger.Info("error unmarshaling response",
+			zap.String("url", url),
 			zap.Error(err),
 		)
-		return 0
+		return err
 	}
 
-	val := property.Values[0].Value
-	return int32(val)
+	if expires := weather.ResponseExpires(resp, time.Now()); !expires.IsZero() && (s.expires.IsZero() || expires.Before(s.expires)) {
+		s.expires = expires
+	}
+	return nil
 }
 
-type propertyValueFloat struct {
-	ValidTime string  `json:"validTime"`
-	Value     float64 `json:"value"`
-}
+func (s *Station) parseFeature(f *feature, now time.Time) (*weather.WeatherReport, []*weather.WeatherForecast, error) {
+	cond := &weather.WeatherCondition{}
+	if val, ok := f.getTimeSeries("temperature", unitCelsius).at(now); ok {
+		cond.Temperature = float32(val)
+	}
+	if val, ok := f.getTimeSeries("dewpoint", unitCelsius).at(now); ok {
+		cond.DewPoint = float32(val)
+	}
+	if val, ok := f.getTimeSeries("relativeHumidity", unitPercent).at(now); ok {
+		cond.Humidity = int32(val)
+	}
+	if val, ok := f.getTimeSeries("windSpeed", unitKilometresPerHour).at(now); ok {
+		cond.WindSpeed = int32(val)
+	}
 
-type propertyFloat struct {
-	SourceUnit    string               `json:"sourceUnit"`
-	UnitOfMeasure string               `json:"uom"`
-	Values        []propertyValueFloat `json:"values"`
-}
+	report := &weather.WeatherReport{
+		Conditions: cond,
+	}
+	forecasts := f.parseForecast()
 
-type propertyValueInt struct {
-	ValidTime string `json:"validTime"`
-	Value     int    `json:"value"`
+	return report, forecasts, nil
 }
 
-type propertyInt struct {
-	SourceUnit    string             `json:"sourceUnit"`
-	UnitOfMeasure string             `json:"uom"`
-	Values        []propertyValueInt `json:"values"`
-}
 type feature struct {
 	ID         interface{}                 `json:"id,omitempty"`
 	Type       string                      `json:"type"`
@@ -0,0 +1,344 @@
+package noaa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rmrobinson/weather"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testServer struct {
+	*httptest.Server
+
+	requests atomic.Int32
+	// failing holds the paths which respond with an error instead of their recorded response.
+	failing sync.Map
+}
+
+// newTestServer creates a stand-in for api.weather.gov which serves the recorded responses in testdata.
+// References to api.weather.gov in the responses are rewritten to point at the stand-in.
+func newTestServer(t *testing.T) *testServer {
+	ts := &testServer{}
+
+	serveTestdata := func(name string, maxAge int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			ts.requests.Add(1)
+			if _, ok := ts.failing.Load(r.URL.Path); ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			assert.Equal(t, "application/geo+json", r.Header.Get("Accept"))
+			assert.Equal(t, weather.DefaultUserAgent, r.Header.Get("User-Agent"))
+
+			body, err := os.ReadFile("testdata/" + name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			w.Header().Set("Content-Type", "application/geo+json")
+			w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+			w.Write([]byte(strings.ReplaceAll(string(body), defaultBaseURL, ts.URL)))
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/points/37.7749,-122.4194", serveTestdata("point.json", 86400))
+	mux.HandleFunc("/gridpoints/MTR/88,126/stations", serveTestdata("stations.json", 86400))
+	mux.HandleFunc("/gridpoints/MTR/88,126", serveTestdata("gridpoint.json", 3600))
+	mux.HandleFunc("/stations/KSFO/observations/latest", serveTestdata("observation.json", 300))
+	mux.HandleFunc("/alerts/active", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "37.7749,-122.4194", r.URL.Query().Get("point"))
+		serveTestdata("alerts.json", 600)(w, r)
+	})
+
+	ts.Server = httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestStation_GetAlerts(t *testing.T) {
+	server := newTestServer(t)
+	s := NewStation(zap.NewNop(), nil, server.URL+"/gridpoints/MTR/88,126", "San Francisco", 37.7749, -122.4194)
+
+	alerts, err := s.getAlerts(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, alerts, 2)
+
+	pst := time.FixedZone("", -8*60*60)
+	assert.Equal(t, &weather.WeatherAlert{
+		AlertId:     "urn:oid:2.49.0.1.840.0.5a1c2f7e0d0a2d4b5c6e7f8091a2b3c4d5e6f708.001.1",
+		Severity:    weather.AlertSeverity_MODERATE,
+		Urgency:     weather.AlertUrgency_EXPECTED,
+		Certainty:   weather.AlertCertainty_LIKELY,
+		Event:       "Wind Advisory",
+		Headline:    "Wind Advisory issued November 20 at 1:40PM PST until November 21 at 4:00AM PST by NWS San Francisco CA",
+		Description: "* WHAT...South winds 20 to 30 mph with gusts up to 50 mph.",
+		Area:        "San Francisco",
+		Polygon: []*weather.GeoPoint{
+			{Latitude: 37.70, Longitude: -122.52},
+			{Latitude: 37.70, Longitude: -122.35},
+			{Latitude: 37.83, Longitude: -122.35},
+			{Latitude: 37.83, Longitude: -122.52},
+			{Latitude: 37.70, Longitude: -122.52},
+		},
+		IssuedAt:    timestamppb.New(time.Date(2024, time.November, 20, 13, 40, 0, 0, pst)),
+		EffectiveAt: timestamppb.New(time.Date(2024, time.November, 20, 13, 40, 0, 0, pst)),
+		ExpiresAt:   timestamppb.New(time.Date(2024, time.November, 21, 4, 0, 0, 0, pst)),
+	}, alerts[0])
+
+	// Zone based alerts have no geometry, and fall back to the message expiry.
+	assert.Equal(t, weather.AlertSeverity_MINOR, alerts[1].Severity)
+	assert.Equal(t, weather.AlertUrgency_FUTURE, alerts[1].Urgency)
+	assert.Equal(t, weather.AlertCertainty_POSSIBLE, alerts[1].Certainty)
+	assert.Empty(t, alerts[1].Polygon)
+	assert.Equal(t, timestamppb.New(time.Date(2024, time.November, 20, 15, 0, 0, 0, pst)), alerts[1].ExpiresAt)
+}
+
+func TestAlertFeature_MultiPolygon(t *testing.T) {
+	body, err := os.ReadFile("testdata/alert_multipolygon.json")
+	assert.NoError(t, err)
+
+	feature := &alertFeature{}
+	assert.NoError(t, json.Unmarshal(body, feature))
+
+	// Each polygon is kept, with the first also set as the polygon of the alert.
+	alert := feature.toAlert()
+	if assert.Len(t, alert.Polygons, 2) {
+		assert.Equal(t, alert.Polygon, alert.Polygons[0].Points)
+		assert.Equal(t, &weather.GeoPoint{Latitude: 37.75, Longitude: -122.30}, alert.Polygons[1].Points[0])
+	}
+	assert.Len(t, alert.Polygon, 5)
+}
+
+func TestStation_ParseFeature(t *testing.T) {
+	server := newTestServer(t)
+	s := NewStation(zap.NewNop(), nil, server.URL+"/gridpoints/MTR/88,126", "San Francisco", 37.7749, -122.4194)
+
+	f, err := s.getFeature(context.Background())
+	assert.NoError(t, err)
+
+	report, forecasts, err := s.parseFeature(f, time.Date(2024, time.November, 20, 19, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+
+	// The current conditions come from the intervals covering the supplied time, not the first interval.
+	assert.InDelta(t, 14.44, report.Conditions.Temperature, 0.01)
+	assert.InDelta(t, 9.44, report.Conditions.DewPoint, 0.01)
+	assert.Equal(t, int32(79), report.Conditions.Humidity)
+	assert.Equal(t, int32(27), report.Conditions.WindSpeed)
+	assert.Len(t, forecasts, 3)
+
+	updatedAt := timestamppb.New(time.Date(2024, time.November, 20, 18, 58, 32, 0, time.UTC))
+	for _, forecast := range forecasts {
+		assert.Equal(t, updatedAt.AsTime(), forecast.UpdatedAt.AsTime())
+	}
+
+	assert.Equal(t, time.Date(2024, time.November, 20, 14, 0, 0, 0, time.UTC), forecasts[0].ForecastedFor.AsTime())
+	assert.Equal(t, server.URL+"/gridpoints/MTR/88,126/2024-11-20T14:00:00+00:00/PT13H", forecasts[0].ForecastId)
+	assert.Equal(t, &weather.WeatherCondition{
+		Summary:             "Chance of light rain showers",
+		SummaryIcon:         weather.WeatherIcon_CHANCE_OF_RAIN,
+		Temperature:         15,
+		WindSpeed:           27,
+		PrecipitationChance: 71,
+	}, forecasts[0].Conditions)
+
+	assert.Equal(t, time.Date(2024, time.November, 21, 1, 0, 0, 0, time.UTC), forecasts[1].ForecastedFor.AsTime())
+	assert.Equal(t, &weather.WeatherCondition{
+		Summary:             "Mostly cloudy",
+		SummaryIcon:         weather.WeatherIcon_MOSTLY_CLOUDY,
+		Temperature:         10,
+		WindSpeed:           14,
+		PrecipitationChance: 3,
+	}, forecasts[1].Conditions)
+
+	assert.Equal(t, time.Date(2024, time.November, 21, 14, 0, 0, 0, time.UTC), forecasts[2].ForecastedFor.AsTime())
+	assert.Equal(t, "Sunny", forecasts[2].Conditions.Summary)
+	assert.Equal(t, weather.WeatherIcon_SUNNY, forecasts[2].Conditions.SummaryIcon)
+	assert.InDelta(t, 16.11, forecasts[2].Conditions.Temperature, 0.01)
+}
+
+func TestStation_ResolvePoint(t *testing.T) {
+	server := newTestServer(t)
+	s := NewStationAt(zap.NewNop(), nil, "San Francisco", 37.7749, -122.4194)
+	s.baseURL = server.URL
+
+	p, err := s.resolvePoint(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &point{
+		gridpointURL:         server.URL + "/gridpoints/MTR/88,126",
+		forecastZone:         server.URL + "/zones/forecast/CAZ006",
+		timeZone:             "America/Los_Angeles",
+		observationStationID: "KSFO",
+	}, p)
+	assert.Equal(t, int32(2), server.requests.Load())
+	assert.Equal(t, "America/Los_Angeles", s.TimeZone().String())
+
+	// The point is cached once resolved.
+	_, err = s.resolvePoint(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), server.requests.Load())
+
+	err = s.Refresh(context.Background())
+	assert.NoError(t, err)
+
+	forecasts, err := s.GetForecast(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, forecasts, 3)
+}
+
+func TestStation_GetReport(t *testing.T) {
+	server := newTestServer(t)
+	s := NewStationAt(zap.NewNop(), nil, "San Francisco", 37.7749, -122.4194)
+	s.baseURL = server.URL
+
+	// Nothing is fetched by reading an empty cache.
+	_, err := s.GetReport(context.Background())
+	assert.ErrorIs(t, err, weather.ErrNoData)
+	assert.Equal(t, int32(0), server.requests.Load())
+
+	// Concurrent requests, each after a refresh, share a single fetch.
+	var wg sync.WaitGroup
+	reports := make([]*weather.WeatherReport, 20)
+	for i := range reports {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			err := s.Refresh(context.Background())
+			assert.NoError(t, err)
+
+			report, err := s.GetReport(context.Background())
+			assert.NoError(t, err)
+			reports[i] = report
+		}(i)
+	}
+	wg.Wait()
+
+	// The point, observation stations, gridpoint, latest observation and alerts are each requested once.
+	assert.Equal(t, int32(5), server.requests.Load())
+	for _, report := range reports {
+		assert.Same(t, reports[0], report)
+	}
+
+	// The observation is the first response to be out of date.
+	assert.WithinDuration(t, time.Now().Add(time.Minute*5), s.NextRefresh(), time.Second*5)
+
+	report := reports[0]
+
+	observedAt := timestamppb.New(time.Date(2024, time.November, 20, 21, 56, 0, 0, time.UTC))
+	assert.Equal(t, server.URL+"/stations/KSFO/observations/2024-11-20T21:56:00+00:00", report.ObservationId)
+	assert.Equal(t, observedAt.AsTime(), report.ObservedAt.AsTime())
+	assert.Equal(t, &weather.WeatherCondition{
+		Summary:     "Mostly Cloudy",
+		SummaryIcon: weather.WeatherIcon_MOSTLY_CLOUDY,
+		Temperature: 14.4,
+		DewPoint:    12.2,
+		Humidity:    86,
+		Pressure:    101.66,
+		WindSpeed:   24,
+		Visibility:  16,
+	}, report.Conditions)
+}
+
+func TestStation_PartialRefresh(t *testing.T) {
+	server := newTestServer(t)
+	s := NewStationAt(zap.NewNop(), nil, "San Francisco", 37.7749, -122.4194)
+	s.baseURL = server.URL
+
+	err := s.Refresh(context.Background())
+	assert.NoError(t, err)
+	first := s.Snapshot()
+	assert.False(t, s.Stale())
+
+	// The previous observation is kept, rather than being replaced by the modelled gridpoint conditions.
+	server.failing.Store("/stations/KSFO/observations/latest", true)
+	err = s.Refresh(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, s.Stale())
+	assert.Same(t, first.Report, s.Snapshot().Report)
+	assert.NotSame(t, first.Forecast[0], s.Snapshot().Forecast[0])
+	assert.Equal(t, first.RefreshedAt, s.LastRefreshed())
+
+	// The observation is still retrieved while the gridpoint is unavailable.
+	server.failing.Delete("/stations/KSFO/observations/latest")
+	server.failing.Store("/gridpoints/MTR/88,126", true)
+	err = s.Refresh(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, s.Stale())
+	second := s.Snapshot()
+	assert.NotSame(t, first.Report, second.Report)
+	assert.Equal(t, first.Report.ObservationId, second.Report.ObservationId)
+	assert.Equal(t, first.Forecast, second.Forecast)
+	assert.Equal(t, first.RefreshedAt, second.RefreshedAt)
+
+	// Nothing is retrieved, so the cached data is left unchanged.
+	server.failing.Store("/stations/KSFO/observations/latest", true)
+	err = s.Refresh(context.Background())
+	assert.Error(t, err)
+	assert.True(t, s.Stale())
+	assert.Same(t, second, s.Snapshot())
+
+	server.failing.Delete("/stations/KSFO/observations/latest")
+	server.failing.Delete("/gridpoints/MTR/88,126")
+	err = s.Refresh(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, s.Stale())
+	assert.True(t, s.LastRefreshed().After(first.RefreshedAt))
+}
+
+func TestPropertyWeather_FirstEmpty(t *testing.T) {
+	property := &propertyWeather{}
+	err := json.Unmarshal([]byte(`{"values": [
+		{"validTime": "2024-11-20T14:00:00+00:00/PT6H", "value": [{"coverage": null, "weather": "", "intensity": null}]},
+		{"validTime": "2024-11-20T20:00:00+00:00/PT6H", "value": [{"coverage": null, "weather": null, "intensity": null}]}
+	]}`), property)
+	assert.NoError(t, err)
+
+	// Values without any weather fall back to the sky cover.
+	start := time.Date(2024, time.November, 20, 14, 0, 0, 0, time.UTC)
+	wv := property.first(interval{start, start.Add(time.Hour * 12)})
+	assert.Nil(t, wv)
+
+	summary, icon := describeConditions(wv, 90, true, 0, true)
+	assert.Equal(t, "Cloudy", summary)
+	assert.Equal(t, weather.WeatherIcon_CLOUDY, icon)
+
+	// An empty weather value doesn't panic if it is described.
+	empty := ""
+	summary, _ = describeConditions(&weatherValue{Weather: &empty}, 0, false, 0, true)
+	assert.Empty(t, summary)
+}
+
+type iconFromURLTest struct {
+	name   string
+	url    string
+	result weather.WeatherIcon
+}
+
+var iconFromURLTests = []iconFromURLTest{
+	{"single condition", "https://api.weather.gov/icons/land/night/ovc?size=medium", weather.WeatherIcon_CLOUDY},
+	{"condition with probability", "https://api.weather.gov/icons/land/day/rain_showers,40?size=medium", weather.WeatherIcon_RAIN},
+	{"multiple conditions", "https://api.weather.gov/icons/land/day/tsra_sct,20/rain,50?size=medium", weather.WeatherIcon_THUNDERSTORMS},
+	{"unknown condition", "https://api.weather.gov/icons/land/day/unknown?size=medium", weather.WeatherIcon_SUNNY},
+	{"empty", "", weather.WeatherIcon_SUNNY},
+}
+
+func TestIconFromURL(t *testing.T) {
+	for _, tt := range iconFromURLTests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.result, iconFromURL(tt.url))
+		})
+	}
+}
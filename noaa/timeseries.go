@@ -0,0 +1,238 @@
+package noaa
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidValidTime is returned if a validTime interval can't be parsed.
+	ErrInvalidValidTime = errors.New("invalid validTime supplied")
+	// ErrInvalidDuration is returned if an ISO 8601 duration can't be parsed.
+	ErrInvalidDuration = errors.New("invalid duration supplied")
+)
+
+type propertyValue struct {
+	ValidTime string   `json:"validTime"`
+	Value     *float64 `json:"value"`
+}
+
+type property struct {
+	SourceUnit    string          `json:"sourceUnit"`
+	UnitOfMeasure string          `json:"uom"`
+	Values        []propertyValue `json:"values"`
+}
+
+// interval is the period of time over which a time series value is valid.
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+func (i interval) contains(t time.Time) bool {
+	return !t.Before(i.start) && t.Before(i.end)
+}
+
+func (i interval) overlaps(start time.Time, end time.Time) bool {
+	return i.start.Before(end) && i.end.After(start)
+}
+
+type timeSeriesValue struct {
+	interval
+
+	validTime string
+	// value is nil if it is unknown for this interval.
+	value *float64
+}
+
+// timeSeries is a gridpoint property made up of values which are each valid over an interval of time.
+// All methods are safe to call on a nil series, which is treated as having no known values.
+type timeSeries struct {
+	values []timeSeriesValue
+}
+
+// parseTimeSeries converts a gridpoint property into a time series, with the values converted into the supplied unit.
+// Values with an invalid validTime are skipped.
+func parseTimeSeries(raw json.RawMessage, to string) (*timeSeries, error) {
+	prop := &property{}
+	err := json.Unmarshal(raw, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := &timeSeries{}
+	for _, value := range prop.Values {
+		validFor, err := parseValidTime(value.ValidTime)
+		if err != nil {
+			continue
+		}
+
+		tsv := timeSeriesValue{
+			interval:  validFor,
+			validTime: value.ValidTime,
+		}
+		if value.Value != nil {
+			val, err := convert(*value.Value, prop.UnitOfMeasure, to)
+			if err != nil {
+				return nil, err
+			}
+			tsv.value = &val
+		}
+
+		ts.values = append(ts.values, tsv)
+	}
+
+	return ts, nil
+}
+
+// at returns the value of the interval covering the supplied instant.
+// False is returned if no interval covers the instant, or the value for the interval is unknown.
+func (ts *timeSeries) at(t time.Time) (float64, bool) {
+	if ts == nil {
+		return 0, false
+	}
+
+	for _, value := range ts.values {
+		if value.contains(t) {
+			if value.value == nil {
+				return 0, false
+			}
+			return *value.value, true
+		}
+	}
+
+	return 0, false
+}
+
+// overlapping returns the known values of the intervals which overlap the supplied range.
+func (ts *timeSeries) overlapping(start time.Time, end time.Time) []float64 {
+	if ts == nil {
+		return nil
+	}
+
+	var vals []float64
+	for _, value := range ts.values {
+		if value.value != nil && value.overlaps(start, end) {
+			vals = append(vals, *value.value)
+		}
+	}
+
+	return vals
+}
+
+func (ts *timeSeries) max(start time.Time, end time.Time) (float64, bool) {
+	vals := ts.overlapping(start, end)
+	if len(vals) < 1 {
+		return 0, false
+	}
+
+	ret := vals[0]
+	for _, val := range vals[1:] {
+		if val > ret {
+			ret = val
+		}
+	}
+	return ret, true
+}
+
+func (ts *timeSeries) min(start time.Time, end time.Time) (float64, bool) {
+	vals := ts.overlapping(start, end)
+	if len(vals) < 1 {
+		return 0, false
+	}
+
+	ret := vals[0]
+	for _, val := range vals[1:] {
+		if val < ret {
+			ret = val
+		}
+	}
+	return ret, true
+}
+
+func (ts *timeSeries) mean(start time.Time, end time.Time) (float64, bool) {
+	vals := ts.overlapping(start, end)
+	if len(vals) < 1 {
+		return 0, false
+	}
+
+	total := float64(0)
+	for _, val := range vals {
+		total += val
+	}
+	return total / float64(len(vals)), true
+}
+
+// parseValidTime parses an ISO 8601 interval of the form "2024-11-20T10:00:00+00:00/PT3H".
+func parseValidTime(validTime string) (interval, error) {
+	parts := strings.Split(validTime, "/")
+	if len(parts) != 2 {
+		return interval{}, ErrInvalidValidTime
+	}
+
+	start, err := time.Parse(time.RFC3339, parts[0])
+	if err != nil {
+		return interval{}, ErrInvalidValidTime
+	}
+
+	duration, err := parseDuration(parts[1])
+	if err != nil {
+		return interval{}, err
+	}
+
+	return interval{start, start.Add(duration)}, nil
+}
+
+// parseDuration parses an ISO 8601 duration of the form "P1DT12H".
+// Years and months aren't a fixed length so they aren't supported.
+func parseDuration(duration string) (time.Duration, error) {
+	if !strings.HasPrefix(duration, "P") || len(duration) < 3 {
+		return 0, ErrInvalidDuration
+	}
+
+	ret := time.Duration(0)
+	inTime := false
+	number := ""
+	for _, c := range duration[1:] {
+		if c >= '0' && c <= '9' || c == '.' {
+			number += string(c)
+			continue
+		} else if c == 'T' {
+			inTime = true
+			continue
+		}
+
+		val, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return 0, ErrInvalidDuration
+		}
+		number = ""
+
+		var scale time.Duration
+		switch {
+		case c == 'W' && !inTime:
+			scale = time.Hour * 24 * 7
+		case c == 'D' && !inTime:
+			scale = time.Hour * 24
+		case c == 'H' && inTime:
+			scale = time.Hour
+		case c == 'M' && inTime:
+			scale = time.Minute
+		case c == 'S' && inTime:
+			scale = time.Second
+		default:
+			return 0, ErrInvalidDuration
+		}
+
+		ret += time.Duration(val * float64(scale))
+	}
+
+	if len(number) > 0 {
+		return 0, ErrInvalidDuration
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,107 @@
+package noaa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseValidTime(t *testing.T) {
+	validFor, err := parseValidTime("2024-11-20T10:00:00+00:00/P1DT12H30M")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.November, 20, 10, 0, 0, 0, time.UTC), validFor.start.UTC())
+	assert.Equal(t, time.Date(2024, time.November, 21, 22, 30, 0, 0, time.UTC), validFor.end.UTC())
+
+	_, err = parseValidTime("2024-11-20T10:00:00+00:00/P1M")
+	assert.Equal(t, ErrInvalidDuration, err)
+
+	_, err = parseValidTime("2024-11-20T10:00:00+00:00")
+	assert.Equal(t, ErrInvalidValidTime, err)
+}
+
+type timeSeriesAtTest struct {
+	name  string
+	at    time.Time
+	value float64
+	ok    bool
+}
+
+var timeSeriesAtTests = []timeSeriesAtTest{
+	{
+		"before the series",
+		time.Date(2024, time.November, 20, 9, 59, 0, 0, time.UTC),
+		0,
+		false,
+	},
+	{
+		"start of first interval",
+		time.Date(2024, time.November, 20, 10, 0, 0, 0, time.UTC),
+		10,
+		true,
+	},
+	{
+		"end of first interval is the start of the second",
+		time.Date(2024, time.November, 20, 13, 0, 0, 0, time.UTC),
+		12,
+		true,
+	},
+	{
+		"null value is unknown",
+		time.Date(2024, time.November, 20, 15, 30, 0, 0, time.UTC),
+		0,
+		false,
+	},
+	{
+		"after the series",
+		time.Date(2024, time.November, 21, 0, 0, 0, 0, time.UTC),
+		0,
+		false,
+	},
+}
+
+func TestTimeSeries_At(t *testing.T) {
+	ts, err := parseTimeSeries([]byte(`{
+		"uom": "wmoUnit:degC",
+		"values": [
+			{"validTime": "2024-11-20T10:00:00+00:00/PT3H", "value": 10},
+			{"validTime": "2024-11-20T13:00:00+00:00/PT2H", "value": 12},
+			{"validTime": "2024-11-20T15:00:00+00:00/PT1H", "value": null},
+			{"validTime": "invalid", "value": 4}
+		]
+	}`), unitCelsius)
+	assert.NoError(t, err)
+	assert.Len(t, ts.values, 3)
+
+	for _, tt := range timeSeriesAtTests {
+		t.Run(tt.name, func(t *testing.T) {
+			val, ok := ts.at(tt.at)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.value, val)
+		})
+	}
+
+	// A nil series has no known values
+	var empty *timeSeries
+	_, ok := empty.at(time.Date(2024, time.November, 20, 10, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestParseTimeSeries_Units(t *testing.T) {
+	ts, err := parseTimeSeries([]byte(`{
+		"uom": "wmoUnit:m_s-1",
+		"values": [
+			{"validTime": "2024-11-20T10:00:00+00:00/PT3H", "value": 5}
+		]
+	}`), unitKilometresPerHour)
+	assert.NoError(t, err)
+	assert.Equal(t, 18.0, *ts.values[0].value)
+
+	_, err = parseTimeSeries([]byte(`{
+		"uom": "wmoUnit:furlong_fortnight-1",
+		"values": [
+			{"validTime": "2024-11-20T10:00:00+00:00/PT3H", "value": 5}
+		]
+	}`), unitKilometresPerHour)
+	assert.ErrorIs(t, err, ErrUnknownUnit)
+}
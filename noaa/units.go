@@ -0,0 +1,105 @@
+package noaa
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrUnknownUnit is returned if a value is supplied in a unit of measure which can't be converted.
+	ErrUnknownUnit = errors.New("unknown unit of measure")
+	// ErrIncompatibleUnits is returned if a value is converted between units which measure different quantities.
+	ErrIncompatibleUnits = errors.New("incompatible units of measure")
+)
+
+// The canonical units which values are reported in, as documented in weather.proto.
+const (
+	unitCelsius           = "wmoUnit:degC"
+	unitKilopascals       = "wmoUnit:kPa"
+	unitKilometresPerHour = "wmoUnit:km_h-1"
+	unitKilometres        = "wmoUnit:km"
+	unitMillimetres       = "wmoUnit:mm"
+	unitPercent           = "wmoUnit:percent"
+)
+
+type dimension int
+
+const (
+	dimensionTemperature dimension = iota
+	dimensionSpeed
+	dimensionPressure
+	dimensionLength
+	dimensionRatio
+	dimensionAngle
+)
+
+// unit describes how to convert a value into the base unit of its dimension (Celsius, km/h, kPa, m, % and degrees).
+type unit struct {
+	dimension dimension
+	scale     float64
+	offset    float64
+}
+
+// units contains the UCUM and WMO codes NOAA reports values with, keyed without their "wmoUnit:", "nwsUnit:" or
+// "unit:" prefix as older responses use the latter.
+// See https://codes.wmo.int/common/unit
+var units = map[string]unit{
+	"degC": {dimensionTemperature, 1, 0},
+	"degF": {dimensionTemperature, 5.0 / 9.0, -32 * 5.0 / 9.0},
+	"K":    {dimensionTemperature, 1, -273.15},
+
+	"km_h-1": {dimensionSpeed, 1, 0},
+	"m_s-1":  {dimensionSpeed, 3.6, 0},
+	"kt":     {dimensionSpeed, 1.852, 0},
+	"mi_h-1": {dimensionSpeed, 1.609344, 0},
+
+	"kPa": {dimensionPressure, 1, 0},
+	"hPa": {dimensionPressure, 0.1, 0},
+	"Pa":  {dimensionPressure, 0.001, 0},
+	"mb":  {dimensionPressure, 0.1, 0},
+
+	"m":  {dimensionLength, 1, 0},
+	"km": {dimensionLength, 1000, 0},
+	"mm": {dimensionLength, 0.001, 0},
+	"cm": {dimensionLength, 0.01, 0},
+	"mi": {dimensionLength, 1609.344, 0},
+	"ft": {dimensionLength, 0.3048, 0},
+	"in": {dimensionLength, 0.0254, 0},
+
+	"percent": {dimensionRatio, 1, 0},
+
+	"degree_(angle)": {dimensionAngle, 1, 0},
+}
+
+func lookupUnit(uom string) (unit, error) {
+	code := uom
+	if idx := strings.Index(uom, ":"); idx >= 0 {
+		code = uom[idx+1:]
+	}
+
+	u, ok := units[code]
+	if !ok {
+		return unit{}, fmt.Errorf("%w: %q", ErrUnknownUnit, uom)
+	}
+	return u, nil
+}
+
+// convert the supplied value from one unit of measure to another.
+func convert(val float64, from string, to string) (float64, error) {
+	fromUnit, err := lookupUnit(from)
+	if err != nil {
+		return 0, err
+	}
+	toUnit, err := lookupUnit(to)
+	if err != nil {
+		return 0, err
+	}
+
+	if fromUnit.dimension != toUnit.dimension {
+		return 0, fmt.Errorf("%w: %q to %q", ErrIncompatibleUnits, from, to)
+	}
+
+	base := val*fromUnit.scale + fromUnit.offset
+	return (base - toUnit.offset) / toUnit.scale, nil
+}
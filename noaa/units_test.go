@@ -0,0 +1,42 @@
+package noaa
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type convertTest struct {
+	name   string
+	value  float64
+	from   string
+	to     string
+	result float64
+	err    error
+}
+
+var convertTests = []convertTest{
+	{"fahrenheit", 212, "wmoUnit:degF", unitCelsius, 100, nil},
+	{"legacy fahrenheit", 32, "unit:degF", unitCelsius, 0, nil},
+	{"kelvin", 273.15, "wmoUnit:K", unitCelsius, 0, nil},
+	{"metres per second", 10, "wmoUnit:m_s-1", unitKilometresPerHour, 36, nil},
+	{"knots", 10, "wmoUnit:kt", unitKilometresPerHour, 18.52, nil},
+	{"pascals", 101325, "wmoUnit:Pa", unitKilopascals, 101.325, nil},
+	{"metres to kilometres", 16090, "wmoUnit:m", unitKilometres, 16.09, nil},
+	{"metres to millimetres", 0.0254, "wmoUnit:m", unitMillimetres, 25.4, nil},
+	{"same unit", 45, "wmoUnit:percent", unitPercent, 45, nil},
+	{"unknown unit", 1, "wmoUnit:furlong", unitKilometres, 0, ErrUnknownUnit},
+	{"incompatible units", 1, "wmoUnit:Pa", unitCelsius, 0, ErrIncompatibleUnits},
+}
+
+func TestConvert(t *testing.T) {
+	for _, tt := range convertTests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := convert(tt.value, tt.from, tt.to)
+			assert.ErrorIs(t, err, tt.err)
+			if tt.err == nil {
+				assert.InDelta(t, tt.result, res, 0.0001)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package weather
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+)
+
+// ErrOffline is returned instead of making an upstream request while in offline mode.
+var ErrOffline = errors.New("offline mode, upstream requests disabled")
+
+// OfflineSwitch controls offline mode, in which no upstream requests are made and requests are only answered
+// from the data already retrieved. It is shared by the API, the scheduler and the HTTP clients of the providers.
+// A nil switch is always online.
+type OfflineSwitch struct {
+	logger  *zap.Logger
+	offline atomic.Bool
+}
+
+// NewOfflineSwitch creates a new switch, starting in the supplied mode.
+func NewOfflineSwitch(logger *zap.Logger, offline bool) *OfflineSwitch {
+	o := &OfflineSwitch{
+		logger: logger,
+	}
+	o.offline.Store(offline)
+	return o
+}
+
+// Offline returns true if in offline mode.
+func (o *OfflineSwitch) Offline() bool {
+	if o == nil {
+		return false
+	}
+	return o.offline.Load()
+}
+
+// Set switches to offline mode if offline is true, and back online otherwise.
+func (o *OfflineSwitch) Set(offline bool) {
+	if o.offline.Swap(offline) != offline {
+		o.logger.Info("offline mode changed",
+			zap.Bool("offline", offline),
+		)
+	}
+}
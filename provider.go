@@ -0,0 +1,151 @@
+package weather
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrUnknownProvider is returned if a station is requested from a provider which hasn't been registered.
+	ErrUnknownProvider = errors.New("unknown provider")
+	// ErrMissingConfig is returned if a required station configuration value isn't set.
+	ErrMissingConfig = errors.New("missing config value")
+	// ErrInvalidConfig is returned if a station configuration value is of the wrong type or out of range.
+	ErrInvalidConfig = errors.New("invalid config value")
+)
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]Provider{}
+)
+
+// StationConfig is the provider specific configuration of a single station, as read from a config file.
+type StationConfig map[string]interface{}
+
+// String returns the named value, or an empty string if it isn't set.
+func (sc StationConfig) String(key string) string {
+	val, ok := sc[key]
+	if !ok || val == nil {
+		return ""
+	}
+	return fmt.Sprint(val)
+}
+
+// Float64 returns the named value. Numeric strings are accepted as config files are often loosely typed.
+func (sc StationConfig) Float64(key string) (float64, error) {
+	val, ok := sc[key]
+	if !ok || val == nil {
+		return 0, fmt.Errorf("%w: %s", ErrMissingConfig, key)
+	}
+
+	switch v := val.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err == nil {
+			return f, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s is not a number", ErrInvalidConfig, key)
+}
+
+// Coordinates returns the latitude and longitude (in degrees) of the station.
+func (sc StationConfig) Coordinates() (float64, float64, error) {
+	lat, err := sc.Float64("latitude")
+	if err != nil {
+		return 0, 0, err
+	} else if lat < -90 || lat > 90 {
+		return 0, 0, fmt.Errorf("%w: latitude %f is out of range", ErrInvalidConfig, lat)
+	}
+
+	lon, err := sc.Float64("longitude")
+	if err != nil {
+		return 0, 0, err
+	} else if lon < -180 || lon > 180 {
+		return 0, 0, fmt.Errorf("%w: longitude %f is out of range", ErrInvalidConfig, lon)
+	}
+
+	return lat, lon, nil
+}
+
+// TimeZone returns the IANA time zone named by the "time_zone" value, such as "America/Toronto".
+// Nil is returned if it isn't set.
+func (sc StationConfig) TimeZone() (*time.Location, error) {
+	name := sc.String("time_zone")
+	if len(name) < 1 {
+		return nil, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: time_zone %q is unknown", ErrInvalidConfig, name)
+	}
+	return loc, nil
+}
+
+// ProviderFactory creates a station from its configuration.
+type ProviderFactory func(logger *zap.Logger, client *HTTPClient, config StationConfig) (Station, error)
+
+// Provider describes a source of weather stations which can be created from configuration.
+type Provider struct {
+	// NewStation creates a single station of this provider.
+	NewStation ProviderFactory
+	// HTTPConfig is the default configuration of requests made to this provider.
+	HTTPConfig HTTPConfig
+}
+
+// RegisterProvider makes a provider available by the supplied name.
+// It is intended to be called from the init function of the package implementing the provider,
+// and panics if the name is registered twice.
+func RegisterProvider(name string, provider Provider) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+
+	if provider.NewStation == nil {
+		panic("weather: RegisterProvider factory is nil for " + name)
+	} else if _, exists := providers[name]; exists {
+		panic("weather: RegisterProvider called twice for " + name)
+	}
+
+	providers[name] = provider
+}
+
+// LookupProvider returns the provider registered with the supplied name.
+func LookupProvider(name string) (Provider, error) {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+
+	provider, ok := providers[name]
+	if !ok {
+		return Provider{}, fmt.Errorf("%w: %q", ErrUnknownProvider, name)
+	}
+	return provider, nil
+}
+
+// Providers returns the sorted names of the registered providers.
+func Providers() []string {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+
+	var names []string
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
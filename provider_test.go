@@ -0,0 +1,99 @@
+package weather
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestStationConfig_Coordinates(t *testing.T) {
+	tests := []struct {
+		name   string
+		config StationConfig
+		lat    float64
+		lon    float64
+		err    error
+	}{
+		{
+			name:   "numbers",
+			config: StationConfig{"latitude": 43.451, "longitude": -80.488},
+			lat:    43.451,
+			lon:    -80.488,
+		},
+		{
+			name:   "integers",
+			config: StationConfig{"latitude": 43, "longitude": int64(-80)},
+			lat:    43,
+			lon:    -80,
+		},
+		{
+			name:   "strings",
+			config: StationConfig{"latitude": "43.451", "longitude": "-80.488"},
+			lat:    43.451,
+			lon:    -80.488,
+		},
+		{
+			name:   "missing longitude",
+			config: StationConfig{"latitude": 43.451},
+			err:    ErrMissingConfig,
+		},
+		{
+			name:   "not a number",
+			config: StationConfig{"latitude": "north", "longitude": -80.488},
+			err:    ErrInvalidConfig,
+		},
+		{
+			name:   "out of range",
+			config: StationConfig{"latitude": 43.451, "longitude": -280.488},
+			err:    ErrInvalidConfig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lat, lon, err := tt.config.Coordinates()
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.lat, lat)
+			assert.Equal(t, tt.lon, lon)
+		})
+	}
+}
+
+func TestRegisterProvider(t *testing.T) {
+	var received StationConfig
+	RegisterProvider("test", Provider{
+		NewStation: func(logger *zap.Logger, client *HTTPClient, config StationConfig) (Station, error) {
+			received = config
+			return &testStation{name: config.String("name")}, nil
+		},
+		HTTPConfig: HTTPConfig{Accept: "application/json"},
+	})
+	defer func() {
+		providersMu.Lock()
+		delete(providers, "test")
+		providersMu.Unlock()
+	}()
+
+	assert.Contains(t, Providers(), "test")
+	assert.Panics(t, func() {
+		RegisterProvider("test", Provider{NewStation: func(*zap.Logger, *HTTPClient, StationConfig) (Station, error) { return nil, nil }})
+	})
+
+	provider, err := LookupProvider("test")
+	assert.NoError(t, err)
+	assert.Equal(t, "application/json", provider.HTTPConfig.Accept)
+
+	station, err := provider.NewStation(zap.NewNop(), nil, StationConfig{"name": "Test"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Test", station.Name())
+	assert.Equal(t, "Test", received.String("name"))
+
+	_, err = LookupProvider("missing")
+	assert.ErrorIs(t, err, ErrUnknownProvider)
+}
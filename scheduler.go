@@ -0,0 +1,274 @@
+package weather
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMinRefreshInterval = time.Minute * 5
+)
+
+// Scheduler refreshes a set of stations in the background so that requests only read the cached state of a station.
+// Each station is refreshed on its own interval, offset by a random jitter so that stations don't all refresh
+// at the same moment, and the number of refreshes in progress at once is bounded.
+// If the upstream provider indicates when a station's data will be out of date, the station is refreshed then instead,
+// as long as that is between the min interval and the interval.
+type Scheduler struct {
+	logger *zap.Logger
+
+	interval    time.Duration
+	minInterval time.Duration
+	jitter      time.Duration
+	sem         chan struct{}
+
+	snapshots *SnapshotStore
+	history   *HistoryStore
+	offline   *OfflineSwitch
+
+	mu       sync.Mutex
+	stations map[string]*scheduledStation
+	ctx      context.Context
+	wg       sync.WaitGroup
+}
+
+// scheduledStation is a station being refreshed, along with the function which stops refreshing it.
+// cancel is nil until the scheduler is running.
+type scheduledStation struct {
+	station Station
+	cancel  context.CancelFunc
+}
+
+// NewScheduler creates a new scheduler which refreshes each station every interval, plus up to jitter,
+// with at most maxConcurrent refreshes in progress at once.
+func NewScheduler(logger *zap.Logger, interval time.Duration, jitter time.Duration, maxConcurrent int) *Scheduler {
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+
+	minInterval := defaultMinRefreshInterval
+	if minInterval > interval {
+		minInterval = interval
+	}
+
+	return &Scheduler{
+		logger:      logger,
+		interval:    interval,
+		minInterval: minInterval,
+		jitter:      jitter,
+		sem:         make(chan struct{}, maxConcurrent),
+		stations:    map[string]*scheduledStation{},
+	}
+}
+
+// SetMinInterval sets the shortest time between refreshes of a station, regardless of when the upstream provider
+// indicates the data will be out of date. It must be called before Run.
+func (s *Scheduler) SetMinInterval(minInterval time.Duration) {
+	s.minInterval = minInterval
+}
+
+// SetSnapshotStore sets the store the data of each station is saved to after it is refreshed.
+// It must be called before Run.
+func (s *Scheduler) SetSnapshotStore(store *SnapshotStore) {
+	s.snapshots = store
+}
+
+// SetHistoryStore sets the store the report of each station is recorded to after it is refreshed.
+// It must be called before Run.
+func (s *Scheduler) SetHistoryStore(store *HistoryStore) {
+	s.history = store
+}
+
+// SetOfflineSwitch sets the switch which controls offline mode. Stations aren't refreshed while offline.
+// It must be called before Run.
+func (s *Scheduler) SetOfflineSwitch(offline *OfflineSwitch) {
+	s.offline = offline
+}
+
+// Add the supplied station to the set being refreshed, replacing any station with the same name and location.
+// If the scheduler is already running the station is scheduled immediately.
+func (s *Scheduler) Add(station Station) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := stationKey(station)
+	if existing := s.stations[key]; existing != nil && existing.cancel != nil {
+		existing.cancel()
+	}
+
+	scheduled := &scheduledStation{
+		station: station,
+	}
+	s.stations[key] = scheduled
+	if s.ctx != nil {
+		s.start(s.ctx, scheduled)
+	}
+}
+
+// Remove stops refreshing the supplied station. It returns false if the station isn't being refreshed.
+// A refresh of the station already in progress isn't waited on.
+func (s *Scheduler) Remove(station Station) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := stationKey(station)
+	scheduled := s.stations[key]
+	if scheduled == nil {
+		return false
+	}
+
+	if scheduled.cancel != nil {
+		scheduled.cancel()
+	}
+	delete(s.stations, key)
+	return true
+}
+
+// Run refreshes the stations until the supplied context is cancelled.
+// It returns once every refresh in progress has completed.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.Lock()
+	s.ctx = ctx
+	for _, scheduled := range s.stations {
+		s.start(ctx, scheduled)
+	}
+	s.mu.Unlock()
+
+	<-ctx.Done()
+	s.wg.Wait()
+}
+
+// start refreshes the supplied station in the background until either the supplied context is cancelled
+// or the station is removed.
+func (s *Scheduler) start(ctx context.Context, scheduled *scheduledStation) {
+	ctx, scheduled.cancel = context.WithCancel(ctx)
+	station := scheduled.station
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		delay := s.initialDelay(station, time.Now())
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+
+			s.refresh(ctx, station)
+			delay = s.nextDelay(station, time.Now()) + s.randomJitter()
+		}
+	}()
+}
+
+func (s *Scheduler) refresh(ctx context.Context, station Station) {
+	if s.offline.Offline() {
+		return
+	}
+
+	select {
+	case <-ctx.Done():
+		return
+	case s.sem <- struct{}{}:
+	}
+	defer func() { <-s.sem }()
+
+	err := station.Refresh(ctx)
+	if err != nil {
+		s.logger.Warn("error refreshing station",
+			zap.String("name", station.Name()),
+			zap.Error(err),
+		)
+		return
+	} else if ctx.Err() != nil {
+		// The station was removed while it was being refreshed.
+		return
+	}
+
+	if s.history != nil {
+		s.recordHistory(ctx, station)
+	}
+
+	if snapshotter, ok := station.(Snapshotter); ok && s.snapshots != nil {
+		data := snapshotter.Snapshot()
+		if data == nil {
+			return
+		}
+
+		err = s.snapshots.Save(station, data)
+		if err != nil {
+			s.logger.Warn("error saving station snapshot",
+				zap.String("name", station.Name()),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (s *Scheduler) recordHistory(ctx context.Context, station Station) {
+	report, err := station.GetReport(ctx)
+	if err != nil || report == nil {
+		return
+	}
+
+	err = s.history.Record(station, report)
+	if err != nil {
+		s.logger.Warn("error recording station history",
+			zap.String("name", station.Name()),
+			zap.Error(err),
+		)
+	}
+}
+
+// initialDelay returns the time to wait before refreshing the supplied station for the first time.
+// Stations which already have data, such as from a snapshot, aren't refreshed until it is due.
+func (s *Scheduler) initialDelay(station Station, now time.Time) time.Duration {
+	// Spread out the initial refreshes, otherwise every station would be refreshed at startup at once.
+	jitter := s.randomJitter()
+
+	lastRefreshed := station.LastRefreshed()
+	if lastRefreshed.IsZero() {
+		return jitter
+	}
+
+	next := station.NextRefresh()
+	if next.IsZero() {
+		next = lastRefreshed.Add(s.interval)
+	}
+
+	delay := next.Sub(now)
+	if delay < 0 {
+		delay = 0
+	} else if delay > s.interval {
+		delay = s.interval
+	}
+	return delay + jitter
+}
+
+// nextDelay returns the time to wait before refreshing the supplied station again.
+func (s *Scheduler) nextDelay(station Station, now time.Time) time.Duration {
+	next := station.NextRefresh()
+	if next.IsZero() {
+		return s.interval
+	}
+
+	delay := next.Sub(now)
+	if delay < s.minInterval {
+		return s.minInterval
+	} else if delay > s.interval {
+		return s.interval
+	}
+	return delay
+}
+
+func (s *Scheduler) randomJitter() time.Duration {
+	if s.jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(s.jitter)))
+}
@@ -0,0 +1,219 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// blockingStation is a station whose refreshes take a while, to observe how many run at once.
+type blockingStation struct {
+	testStation
+
+	inFlight    *atomic.Int32
+	maxInFlight *atomic.Int32
+}
+
+func (s *blockingStation) Refresh(ctx context.Context) error {
+	current := s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+
+	for {
+		highest := s.maxInFlight.Load()
+		if current <= highest || s.maxInFlight.CompareAndSwap(highest, current) {
+			break
+		}
+	}
+
+	time.Sleep(time.Millisecond * 5)
+	return s.testStation.Refresh(ctx)
+}
+
+func TestScheduler_Run(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+
+	scheduler := NewScheduler(zap.NewNop(), time.Millisecond, time.Millisecond, 2)
+
+	var stations []*blockingStation
+	for i := 0; i < 8; i++ {
+		station := &blockingStation{
+			testStation: testStation{
+				name: fmt.Sprintf("station-%d", i),
+			},
+			inFlight:    &inFlight,
+			maxInFlight: &maxInFlight,
+		}
+		stations = append(stations, station)
+		scheduler.Add(station)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		scheduler.Run(ctx)
+		close(done)
+	}()
+
+	// Stations added while running are scheduled as well.
+	late := &blockingStation{
+		testStation: testStation{
+			name: "late",
+		},
+		inFlight:    &inFlight,
+		maxInFlight: &maxInFlight,
+	}
+	scheduler.Add(late)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler didn't stop after the context was cancelled")
+	}
+
+	assert.Equal(t, int32(0), inFlight.Load())
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+	for _, station := range append(stations, late) {
+		station.mu.Lock()
+		assert.Greater(t, station.refreshes, 1)
+		station.mu.Unlock()
+	}
+}
+
+func TestScheduler_Remove(t *testing.T) {
+	scheduler := NewScheduler(zap.NewNop(), time.Millisecond, 0, 1)
+
+	removed := &testStation{name: "Kitchener Waterloo"}
+	replaced := &testStation{name: "San Francisco"}
+	replacement := &testStation{name: "San Francisco"}
+	scheduler.Add(removed)
+	scheduler.Add(replaced)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		scheduler.Run(ctx)
+		close(done)
+	}()
+
+	refreshes := func(s *testStation) int {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.refreshes
+	}
+	assert.Eventually(t, func() bool {
+		return refreshes(removed) > 0 && refreshes(replaced) > 0
+	}, time.Second, time.Millisecond)
+
+	// Removed and replaced stations are no longer refreshed, while their replacements are.
+	assert.True(t, scheduler.Remove(removed))
+	assert.False(t, scheduler.Remove(removed))
+	scheduler.Add(replacement)
+	time.Sleep(time.Millisecond * 10)
+	removedRefreshes, replacedRefreshes := refreshes(removed), refreshes(replaced)
+
+	time.Sleep(time.Millisecond * 20)
+	assert.Equal(t, removedRefreshes, refreshes(removed))
+	assert.Equal(t, replacedRefreshes, refreshes(replaced))
+	assert.Greater(t, refreshes(replacement), 0)
+
+	cancel()
+	<-done
+}
+
+// expiringStation is a station whose upstream provider indicates when its data will be out of date.
+type expiringStation struct {
+	testStation
+
+	nextRefresh time.Time
+}
+
+func (s *expiringStation) NextRefresh() time.Time {
+	return s.nextRefresh
+}
+
+func TestScheduler_NextDelay(t *testing.T) {
+	now := time.Date(2024, time.November, 20, 19, 0, 0, 0, time.UTC)
+	scheduler := NewScheduler(zap.NewNop(), time.Minute*30, 0, 1)
+
+	tests := []struct {
+		name        string
+		nextRefresh time.Time
+		delay       time.Duration
+	}{
+		{"unknown", time.Time{}, time.Minute * 30},
+		{"sooner than the interval", now.Add(time.Minute * 10), time.Minute * 10},
+		{"later than the interval", now.Add(time.Hour), time.Minute * 30},
+		{"sooner than the min interval", now.Add(time.Second * 30), defaultMinRefreshInterval},
+		{"already expired", now.Add(-time.Minute), defaultMinRefreshInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay := scheduler.nextDelay(&expiringStation{nextRefresh: tt.nextRefresh}, now)
+			assert.Equal(t, tt.delay, delay)
+		})
+	}
+}
+
+func TestScheduler_RunSavesSnapshots(t *testing.T) {
+	store, err := NewSnapshotStore(zap.NewNop(), t.TempDir())
+	assert.NoError(t, err)
+
+	station := &cachedStation{
+		name: "Kitchener",
+		StationCache: NewStationCache(func(ctx context.Context, previous *StationData) (*StationData, error) {
+			return &StationData{
+				Report:      &WeatherReport{ObservationId: "obs-1"},
+				RefreshedAt: time.Now(),
+			}, nil
+		}),
+	}
+
+	scheduler := NewScheduler(zap.NewNop(), time.Hour, 0, 1)
+	scheduler.SetSnapshotStore(store)
+	scheduler.Add(station)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	scheduler.Run(ctx)
+
+	data, err := store.Load(station)
+	assert.NoError(t, err)
+	assert.Equal(t, "obs-1", data.Report.ObservationId)
+}
+
+func TestScheduler_InitialDelay(t *testing.T) {
+	now := time.Date(2024, time.November, 20, 19, 0, 0, 0, time.UTC)
+	scheduler := NewScheduler(zap.NewNop(), time.Minute*30, 0, 1)
+
+	tests := []struct {
+		name          string
+		lastRefreshed time.Time
+		nextRefresh   time.Time
+		delay         time.Duration
+	}{
+		{"no data", time.Time{}, time.Time{}, 0},
+		{"restored within the interval", now.Add(-time.Minute * 20), time.Time{}, time.Minute * 10},
+		{"restored older than the interval", now.Add(-time.Hour), time.Time{}, 0},
+		{"restored with an expiry", now.Add(-time.Minute * 20), now.Add(time.Minute * 5), time.Minute * 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			station := &expiringStation{
+				testStation: testStation{
+					refreshedAt: tt.lastRefreshed,
+				},
+				nextRefresh: tt.nextRefresh,
+			}
+			assert.Equal(t, tt.delay, scheduler.initialDelay(station, now))
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package weather
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrSnapshotMismatch is returned if a saved snapshot belongs to a different station than the one requested.
+var ErrSnapshotMismatch = errors.New("snapshot is for a different station")
+
+// Snapshotter is implemented by stations whose data can be saved and later restored.
+// Embedding a StationCache provides it.
+type Snapshotter interface {
+	// Snapshot returns the data of the station, or nil if it has none.
+	Snapshot() *StationData
+	// Restore sets the data of the station, unless it already has newer data.
+	Restore(data *StationData)
+}
+
+// SnapshotStore saves the data of stations as protobuf files in a directory, so that it survives restarts.
+type SnapshotStore struct {
+	logger *zap.Logger
+	dir    string
+}
+
+// NewSnapshotStore creates a new store which saves snapshots in the supplied directory, creating it if required.
+func NewSnapshotStore(logger *zap.Logger, dir string) (*SnapshotStore, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotStore{
+		logger: logger,
+		dir:    dir,
+	}, nil
+}
+
+// Save writes the supplied data of the station, replacing any snapshot previously saved for it.
+func (ss *SnapshotStore) Save(station Station, data *StationData) error {
+	snapshot := &StationSnapshot{
+		StationName: station.Name(),
+		Location: &GeoPoint{
+			Latitude:  station.Latitude(),
+			Longitude: station.Longitude(),
+		},
+		Report:      data.Report,
+		Forecast:    data.Forecast,
+		Alerts:      data.Alerts,
+		RefreshedAt: timestamppb.New(data.RefreshedAt),
+		Stale:       data.Stale,
+	}
+	if !data.Expires.IsZero() {
+		snapshot.Expires = timestamppb.New(data.Expires)
+	}
+
+	body, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a crash part way through doesn't leave a truncated snapshot behind.
+	f, err := os.CreateTemp(ss.dir, ".snapshot-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), ss.path(station))
+}
+
+// Load reads the snapshot previously saved for the station.
+// An error satisfying errors.Is(err, fs.ErrNotExist) is returned if there isn't one.
+func (ss *SnapshotStore) Load(station Station) (*StationData, error) {
+	body, err := os.ReadFile(ss.path(station))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &StationSnapshot{}
+	err = proto.Unmarshal(body, snapshot)
+	if err != nil {
+		return nil, err
+	} else if snapshot.StationName != station.Name() ||
+		snapshot.Location.GetLatitude() != station.Latitude() ||
+		snapshot.Location.GetLongitude() != station.Longitude() {
+		return nil, ErrSnapshotMismatch
+	}
+
+	data := &StationData{
+		Report:      snapshot.Report,
+		Forecast:    snapshot.Forecast,
+		Alerts:      snapshot.Alerts,
+		RefreshedAt: snapshot.RefreshedAt.AsTime(),
+		Stale:       snapshot.Stale,
+	}
+	if snapshot.Expires != nil {
+		data.Expires = snapshot.Expires.AsTime()
+	}
+	return data, nil
+}
+
+// Restore loads the snapshot of each station which supports it, as long as it is no older than maxAge.
+// Zero means there is no limit. It returns the number of stations restored.
+func (ss *SnapshotStore) Restore(stations []Station, maxAge time.Duration) int {
+	restored := 0
+	for _, station := range stations {
+		snapshotter, ok := station.(Snapshotter)
+		if !ok {
+			continue
+		}
+
+		data, err := ss.Load(station)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			ss.logger.Warn("error loading station snapshot",
+				zap.String("name", station.Name()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if age := time.Since(data.RefreshedAt); maxAge > 0 && age > maxAge {
+			ss.logger.Debug("ignoring expired station snapshot",
+				zap.String("name", station.Name()),
+				zap.Duration("age", age),
+			)
+			continue
+		}
+
+		snapshotter.Restore(data)
+		restored++
+	}
+
+	return restored
+}
+
+// path returns the file the snapshot of the station is saved to.
+func (ss *SnapshotStore) path(station Station) string {
+	return filepath.Join(ss.dir, stationKey(station)+".pb")
+}
@@ -0,0 +1,98 @@
+package weather
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSnapshotStore_SaveLoad(t *testing.T) {
+	store, err := NewSnapshotStore(zap.NewNop(), t.TempDir())
+	assert.NoError(t, err)
+
+	station := &testStation{
+		name:      "Kitchener Waterloo",
+		latitude:  43.451,
+		longitude: -80.488,
+	}
+
+	_, err = store.Load(station)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	refreshedAt := time.Date(2024, time.November, 20, 19, 0, 0, 0, time.UTC)
+	data := &StationData{
+		Report: &WeatherReport{
+			ObservationId: "obs-1",
+			Conditions: &WeatherCondition{
+				Temperature: 4.5,
+			},
+		},
+		Forecast: []*WeatherForecast{
+			{ForecastId: "forecast-1"},
+		},
+		Alerts: []*WeatherAlert{
+			{AlertId: "alert-1", Severity: AlertSeverity_SEVERE},
+		},
+		RefreshedAt: refreshedAt,
+		Stale:       true,
+	}
+	err = store.Save(station, data)
+	assert.NoError(t, err)
+
+	loaded, err := store.Load(station)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(data.Report, loaded.Report))
+	assert.Len(t, loaded.Forecast, 1)
+	assert.True(t, proto.Equal(data.Alerts[0], loaded.Alerts[0]))
+	assert.Equal(t, refreshedAt, loaded.RefreshedAt)
+	assert.True(t, loaded.Expires.IsZero())
+	assert.True(t, loaded.Stale)
+
+	// A station with the same name elsewhere doesn't pick up the snapshot.
+	_, err = store.Load(&testStation{
+		name:      "Kitchener Waterloo",
+		latitude:  43.4,
+		longitude: -80.5,
+	})
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestSnapshotStore_Restore(t *testing.T) {
+	store, err := NewSnapshotStore(zap.NewNop(), t.TempDir())
+	assert.NoError(t, err)
+
+	fresh := &cachedStation{name: "Fresh"}
+	expired := &cachedStation{name: "Expired"}
+	missing := &cachedStation{name: "Missing"}
+	for _, station := range []*cachedStation{fresh, expired, missing} {
+		station.StationCache = NewStationCache(func(ctx context.Context, previous *StationData) (*StationData, error) {
+			return nil, context.Canceled
+		})
+	}
+
+	assert.NoError(t, store.Save(fresh, &StationData{
+		Report:      &WeatherReport{ObservationId: "fresh"},
+		RefreshedAt: time.Now().Add(-time.Hour),
+		Stale:       true,
+	}))
+	assert.NoError(t, store.Save(expired, &StationData{
+		Report:      &WeatherReport{ObservationId: "expired"},
+		RefreshedAt: time.Now().Add(-time.Hour * 4),
+	}))
+
+	restored := store.Restore([]Station{fresh, expired, missing}, time.Hour*3)
+	assert.Equal(t, 1, restored)
+
+	// Restored data is returned without a refresh.
+	report, err := fresh.GetReport(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "fresh", report.ObservationId)
+	assert.True(t, fresh.Stale())
+	assert.Nil(t, expired.Snapshot())
+	assert.Nil(t, missing.Snapshot())
+}
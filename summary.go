@@ -0,0 +1,174 @@
+package weather
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// summaryPeriod returns the start and end of the period including the supplied time, in its location.
+type summaryPeriod func(t time.Time) (time.Time, time.Time)
+
+// dailyPeriod aligns periods to local midnight, so days are 23 or 25 hours long when daylight saving time changes.
+func dailyPeriod(t time.Time) (time.Time, time.Time) {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location()), time.Date(year, month, day+1, 0, 0, 0, 0, t.Location())
+}
+
+// hourlyPeriod aligns periods to the local hour, which isn't always the UTC hour as some zones are offset by
+// a fraction of an hour.
+func hourlyPeriod(t time.Time) (time.Time, time.Time) {
+	start := t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	return start, start.Add(time.Hour)
+}
+
+// summaryRange returns the range of times covered by the periods including start, up to those starting before end.
+func summaryRange(start time.Time, end time.Time, period summaryPeriod) (time.Time, time.Time) {
+	first, _ := period(start)
+	lastStart, lastEnd := period(end)
+	if lastStart.Equal(end) {
+		return first, end
+	}
+	return first, lastEnd
+}
+
+// summarizeReports groups the supplied reports, sorted by observation time, into periods in the supplied location,
+// and summarizes each. Periods without any reports are omitted.
+func summarizeReports(reports []*WeatherReport, loc *time.Location, period summaryPeriod) []*WeatherSummary {
+	var summaries []*WeatherSummary
+	var current *summaryBuilder
+	for _, report := range reports {
+		if report.Conditions == nil || report.ObservedAt == nil {
+			continue
+		}
+
+		observedAt := report.ObservedAt.AsTime().In(loc)
+		start, end := period(observedAt)
+		if current == nil || !current.start.Equal(start) {
+			if current != nil {
+				summaries = append(summaries, current.summary())
+			}
+			current = newSummaryBuilder(start, end)
+		}
+		current.add(observedAt, report.Conditions)
+	}
+	if current != nil {
+		summaries = append(summaries, current.summary())
+	}
+
+	return summaries
+}
+
+// summaryBuilder accumulates the conditions reported during a single period.
+type summaryBuilder struct {
+	start time.Time
+	end   time.Time
+
+	count int
+
+	// Reports don't indicate whether the temperature was measured, so a temperature of zero is treated as missing
+	// rather than letting reports without one drag the summary towards zero.
+	temperatureCount int
+	minTemperature   float32
+	maxTemperature   float32
+	sumTemperature   float64
+
+	maxWindSpeed int32
+
+	// The precipitation of a report is for the hour before it, so reports in the same hour overlap.
+	// The largest amount reported in each local hour is used.
+	precipitation map[time.Time]float32
+
+	icons     []WeatherIcon
+	iconCount map[WeatherIcon]int
+	summaries map[WeatherIcon][]string
+}
+
+func newSummaryBuilder(start time.Time, end time.Time) *summaryBuilder {
+	return &summaryBuilder{
+		start:         start,
+		end:           end,
+		precipitation: map[time.Time]float32{},
+		iconCount:     map[WeatherIcon]int{},
+		summaries:     map[WeatherIcon][]string{},
+	}
+}
+
+func (sb *summaryBuilder) add(observedAt time.Time, cond *WeatherCondition) {
+	if cond.Temperature != 0 {
+		if sb.temperatureCount < 1 || cond.Temperature < sb.minTemperature {
+			sb.minTemperature = cond.Temperature
+		}
+		if sb.temperatureCount < 1 || cond.Temperature > sb.maxTemperature {
+			sb.maxTemperature = cond.Temperature
+		}
+		sb.sumTemperature += float64(cond.Temperature)
+		sb.temperatureCount++
+	}
+	if cond.WindSpeed > sb.maxWindSpeed {
+		sb.maxWindSpeed = cond.WindSpeed
+	}
+	sb.count++
+
+	hour, _ := hourlyPeriod(observedAt)
+	if cond.Precipitation > sb.precipitation[hour] {
+		sb.precipitation[hour] = cond.Precipitation
+	}
+
+	if sb.iconCount[cond.SummaryIcon] < 1 {
+		sb.icons = append(sb.icons, cond.SummaryIcon)
+	}
+	sb.iconCount[cond.SummaryIcon]++
+	sb.summaries[cond.SummaryIcon] = append(sb.summaries[cond.SummaryIcon], cond.Summary)
+}
+
+func (sb *summaryBuilder) summary() *WeatherSummary {
+	var totalPrecipitation float32
+	for _, amount := range sb.precipitation {
+		totalPrecipitation += amount
+	}
+
+	var meanTemperature float32
+	if sb.temperatureCount > 0 {
+		meanTemperature = float32(sb.sumTemperature / float64(sb.temperatureCount))
+	}
+
+	// Ties are broken in favour of the condition reported first.
+	dominantIcon := sb.icons[0]
+	for _, icon := range sb.icons[1:] {
+		if sb.iconCount[icon] > sb.iconCount[dominantIcon] {
+			dominantIcon = icon
+		}
+	}
+
+	return &WeatherSummary{
+		Start:              timestamppb.New(sb.start),
+		End:                timestamppb.New(sb.end),
+		ReportCount:        int32(sb.count),
+		MinTemperature:     sb.minTemperature,
+		MaxTemperature:     sb.maxTemperature,
+		MeanTemperature:    meanTemperature,
+		MaxWindSpeed:       sb.maxWindSpeed,
+		TotalPrecipitation: totalPrecipitation,
+		DominantIcon:       dominantIcon,
+		DominantSummary:    mostFrequent(sb.summaries[dominantIcon]),
+	}
+}
+
+// mostFrequent returns the value which occurs the most often, preferring the earliest if there is a tie.
+func mostFrequent(values []string) string {
+	counts := map[string]int{}
+	for _, value := range values {
+		counts[value]++
+	}
+
+	var result string
+	highest := 0
+	for _, value := range values {
+		if counts[value] > highest {
+			result = value
+			highest = counts[value]
+		}
+	}
+	return result
+}
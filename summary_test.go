@@ -0,0 +1,198 @@
+package weather
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSummarizeReports(t *testing.T) {
+	toronto, err := time.LoadLocation("America/Toronto")
+	assert.NoError(t, err)
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	assert.NoError(t, err)
+
+	report := func(observedAt time.Time, temp float32, wind int32, precip float32, icon WeatherIcon, summary string) *WeatherReport {
+		return &WeatherReport{
+			ObservedAt: timestamppb.New(observedAt),
+			Conditions: &WeatherCondition{
+				Temperature:   temp,
+				WindSpeed:     wind,
+				Precipitation: precip,
+				SummaryIcon:   icon,
+				Summary:       summary,
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		reports   []*WeatherReport
+		loc       *time.Location
+		period    summaryPeriod
+		summaries []*WeatherSummary
+	}{
+		{
+			name: "local days",
+			reports: []*WeatherReport{
+				// 23:00 on November 2nd in Toronto, despite being November 3rd in UTC.
+				report(time.Date(2024, time.November, 3, 3, 0, 0, 0, time.UTC), 4, 10, 0, WeatherIcon_CLOUDY, "Cloudy"),
+				report(time.Date(2024, time.November, 3, 12, 0, 0, 0, time.UTC), 2, 20, 1.5, WeatherIcon_RAIN, "Light Rain"),
+				report(time.Date(2024, time.November, 3, 12, 30, 0, 0, time.UTC), 3, 25, 2, WeatherIcon_RAIN, "Rain"),
+				report(time.Date(2024, time.November, 3, 18, 0, 0, 0, time.UTC), 8, 15, 0.5, WeatherIcon_RAIN, "Light Rain"),
+				report(time.Date(2024, time.November, 3, 22, 0, 0, 0, time.UTC), 6, 5, 0, WeatherIcon_CLOUDY, "Cloudy"),
+				{ObservedAt: timestamppb.New(time.Date(2024, time.November, 3, 23, 0, 0, 0, time.UTC))},
+			},
+			loc:    toronto,
+			period: dailyPeriod,
+			summaries: []*WeatherSummary{
+				{
+					Start:           timestamppb.New(time.Date(2024, time.November, 2, 0, 0, 0, 0, toronto)),
+					End:             timestamppb.New(time.Date(2024, time.November, 3, 0, 0, 0, 0, toronto)),
+					ReportCount:     1,
+					MinTemperature:  4,
+					MaxTemperature:  4,
+					MeanTemperature: 4,
+					MaxWindSpeed:    10,
+					DominantIcon:    WeatherIcon_CLOUDY,
+					DominantSummary: "Cloudy",
+				},
+				{
+					// Daylight saving time ends, so the day is 25 hours long.
+					Start:              timestamppb.New(time.Date(2024, time.November, 3, 0, 0, 0, 0, toronto)),
+					End:                timestamppb.New(time.Date(2024, time.November, 4, 0, 0, 0, 0, toronto)),
+					ReportCount:        4,
+					MinTemperature:     2,
+					MaxTemperature:     8,
+					MeanTemperature:    4.75,
+					MaxWindSpeed:       25,
+					TotalPrecipitation: 2.5,
+					DominantIcon:       WeatherIcon_RAIN,
+					DominantSummary:    "Light Rain",
+				},
+			},
+		},
+		{
+			name: "hours offset from UTC",
+			reports: []*WeatherReport{
+				report(time.Date(2024, time.November, 20, 6, 15, 0, 0, time.UTC), 20, 5, 0, WeatherIcon_FOG, "Haze"),
+				report(time.Date(2024, time.November, 20, 6, 45, 0, 0, time.UTC), 22, 5, 0, WeatherIcon_SUNNY, "Sunny"),
+			},
+			loc:    kolkata,
+			period: hourlyPeriod,
+			summaries: []*WeatherSummary{
+				{
+					Start:           timestamppb.New(time.Date(2024, time.November, 20, 11, 0, 0, 0, kolkata)),
+					End:             timestamppb.New(time.Date(2024, time.November, 20, 12, 0, 0, 0, kolkata)),
+					ReportCount:     1,
+					MinTemperature:  20,
+					MaxTemperature:  20,
+					MeanTemperature: 20,
+					MaxWindSpeed:    5,
+					DominantIcon:    WeatherIcon_FOG,
+					DominantSummary: "Haze",
+				},
+				{
+					Start:           timestamppb.New(time.Date(2024, time.November, 20, 12, 0, 0, 0, kolkata)),
+					End:             timestamppb.New(time.Date(2024, time.November, 20, 13, 0, 0, 0, kolkata)),
+					ReportCount:     1,
+					MinTemperature:  22,
+					MaxTemperature:  22,
+					MeanTemperature: 22,
+					MaxWindSpeed:    5,
+					DominantIcon:    WeatherIcon_SUNNY,
+					DominantSummary: "Sunny",
+				},
+			},
+		},
+		{
+			name: "missing temperatures and local hours",
+			reports: []*WeatherReport{
+				// 11:45, 12:15 and 12:40 in Kolkata; the first two are in the same UTC hour.
+				report(time.Date(2024, time.November, 20, 6, 15, 0, 0, time.UTC), 20, 5, 1, WeatherIcon_RAIN, "Rain"),
+				report(time.Date(2024, time.November, 20, 6, 45, 0, 0, time.UTC), 0, 5, 1.5, WeatherIcon_RAIN, "Rain"),
+				report(time.Date(2024, time.November, 20, 7, 10, 0, 0, time.UTC), 22, 10, 0.5, WeatherIcon_RAIN, "Rain"),
+			},
+			loc:    kolkata,
+			period: dailyPeriod,
+			summaries: []*WeatherSummary{
+				{
+					Start:              timestamppb.New(time.Date(2024, time.November, 20, 0, 0, 0, 0, kolkata)),
+					End:                timestamppb.New(time.Date(2024, time.November, 21, 0, 0, 0, 0, kolkata)),
+					ReportCount:        3,
+					MinTemperature:     20,
+					MaxTemperature:     22,
+					MeanTemperature:    21,
+					MaxWindSpeed:       10,
+					TotalPrecipitation: 2.5,
+					DominantIcon:       WeatherIcon_RAIN,
+					DominantSummary:    "Rain",
+				},
+			},
+		},
+		{
+			name:   "no reports",
+			loc:    time.UTC,
+			period: dailyPeriod,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.summaries, summarizeReports(tt.reports, tt.loc, tt.period))
+		})
+	}
+}
+
+func TestSummaryRange(t *testing.T) {
+	toronto, err := time.LoadLocation("America/Toronto")
+	assert.NoError(t, err)
+
+	start, end := summaryRange(
+		time.Date(2024, time.November, 20, 15, 30, 0, 0, toronto),
+		time.Date(2024, time.November, 22, 0, 0, 0, 0, toronto),
+		dailyPeriod,
+	)
+	assert.Equal(t, time.Date(2024, time.November, 20, 0, 0, 0, 0, toronto), start)
+	assert.Equal(t, time.Date(2024, time.November, 22, 0, 0, 0, 0, toronto), end)
+
+	start, end = summaryRange(
+		time.Date(2024, time.November, 20, 15, 30, 0, 0, toronto),
+		time.Date(2024, time.November, 20, 17, 10, 0, 0, toronto),
+		hourlyPeriod,
+	)
+	assert.Equal(t, time.Date(2024, time.November, 20, 15, 0, 0, 0, toronto), start)
+	assert.Equal(t, time.Date(2024, time.November, 20, 18, 0, 0, 0, toronto), end)
+}
+
+type zonedStation struct {
+	*testStation
+	loc *time.Location
+}
+
+func (s *zonedStation) TimeZone() *time.Location {
+	return s.loc
+}
+
+func TestStationTimeZone(t *testing.T) {
+	toronto, err := time.LoadLocation("America/Toronto")
+	assert.NoError(t, err)
+
+	station := &testStation{
+		name:      "Kitchener Waterloo",
+		latitude:  43.451,
+		longitude: -80.488,
+	}
+
+	assert.Equal(t, toronto, StationTimeZone(&zonedStation{station, toronto}))
+
+	// Without a time zone one is approximated from the longitude.
+	loc := StationTimeZone(&zonedStation{station, nil})
+	_, offset := time.Date(2024, time.July, 1, 0, 0, 0, 0, loc).Zone()
+	assert.Equal(t, -5*60*60, offset)
+
+	loc = StationTimeZone(&testStation{longitude: 3.2})
+	assert.Equal(t, time.UTC, loc)
+}
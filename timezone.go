@@ -0,0 +1,30 @@
+package weather
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// TimeZoner is implemented by stations which know the time zone of their location.
+type TimeZoner interface {
+	// TimeZone returns the time zone of the station, or nil if it isn't known.
+	TimeZone() *time.Location
+}
+
+// StationTimeZone returns the time zone of the supplied station.
+// If the station doesn't know it, a fixed zone is approximated from the longitude of the station,
+// which is close to local solar time but ignores daylight saving time and political boundaries.
+func StationTimeZone(station Station) *time.Location {
+	if tz, ok := station.(TimeZoner); ok {
+		if loc := tz.TimeZone(); loc != nil {
+			return loc
+		}
+	}
+
+	offset := int(math.Round(station.Longitude() / 15))
+	if offset == 0 {
+		return time.UTC
+	}
+	return time.FixedZone(fmt.Sprintf("UTC%+d", offset), offset*60*60)
+}
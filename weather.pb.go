@@ -9,6 +9,7 @@ package weather
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -94,6 +95,321 @@ func (WeatherIcon) EnumDescriptor() ([]byte, []int) {
 	return file_weather_proto_rawDescGZIP(), []int{0}
 }
 
+type ReportMode int32
+
+const (
+	// The report of the closest station is returned.
+	ReportMode_CLOSEST ReportMode = 0
+	// The numeric conditions reported by the closest stations are blended, weighted by the inverse of the square
+	// of their distance. Other conditions, such as the summary, are taken from the closest station.
+	ReportMode_INTERPOLATED ReportMode = 1
+)
+
+// Enum value maps for ReportMode.
+var (
+	ReportMode_name = map[int32]string{
+		0: "CLOSEST",
+		1: "INTERPOLATED",
+	}
+	ReportMode_value = map[string]int32{
+		"CLOSEST":      0,
+		"INTERPOLATED": 1,
+	}
+)
+
+func (x ReportMode) Enum() *ReportMode {
+	p := new(ReportMode)
+	*p = x
+	return p
+}
+
+func (x ReportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_weather_proto_enumTypes[1].Descriptor()
+}
+
+func (ReportMode) Type() protoreflect.EnumType {
+	return &file_weather_proto_enumTypes[1]
+}
+
+func (x ReportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportMode.Descriptor instead.
+func (ReportMode) EnumDescriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{1}
+}
+
+type AlertSeverity int32
+
+const (
+	AlertSeverity_UNKNOWN_SEVERITY AlertSeverity = 0
+	AlertSeverity_MINOR            AlertSeverity = 1
+	AlertSeverity_MODERATE         AlertSeverity = 2
+	AlertSeverity_SEVERE           AlertSeverity = 3
+	AlertSeverity_EXTREME          AlertSeverity = 4
+)
+
+// Enum value maps for AlertSeverity.
+var (
+	AlertSeverity_name = map[int32]string{
+		0: "UNKNOWN_SEVERITY",
+		1: "MINOR",
+		2: "MODERATE",
+		3: "SEVERE",
+		4: "EXTREME",
+	}
+	AlertSeverity_value = map[string]int32{
+		"UNKNOWN_SEVERITY": 0,
+		"MINOR":            1,
+		"MODERATE":         2,
+		"SEVERE":           3,
+		"EXTREME":          4,
+	}
+)
+
+func (x AlertSeverity) Enum() *AlertSeverity {
+	p := new(AlertSeverity)
+	*p = x
+	return p
+}
+
+func (x AlertSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_weather_proto_enumTypes[2].Descriptor()
+}
+
+func (AlertSeverity) Type() protoreflect.EnumType {
+	return &file_weather_proto_enumTypes[2]
+}
+
+func (x AlertSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertSeverity.Descriptor instead.
+func (AlertSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{2}
+}
+
+type AlertUrgency int32
+
+const (
+	AlertUrgency_UNKNOWN_URGENCY AlertUrgency = 0
+	AlertUrgency_IMMEDIATE       AlertUrgency = 1
+	AlertUrgency_EXPECTED        AlertUrgency = 2
+	AlertUrgency_FUTURE          AlertUrgency = 3
+	AlertUrgency_PAST            AlertUrgency = 4
+)
+
+// Enum value maps for AlertUrgency.
+var (
+	AlertUrgency_name = map[int32]string{
+		0: "UNKNOWN_URGENCY",
+		1: "IMMEDIATE",
+		2: "EXPECTED",
+		3: "FUTURE",
+		4: "PAST",
+	}
+	AlertUrgency_value = map[string]int32{
+		"UNKNOWN_URGENCY": 0,
+		"IMMEDIATE":       1,
+		"EXPECTED":        2,
+		"FUTURE":          3,
+		"PAST":            4,
+	}
+)
+
+func (x AlertUrgency) Enum() *AlertUrgency {
+	p := new(AlertUrgency)
+	*p = x
+	return p
+}
+
+func (x AlertUrgency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertUrgency) Descriptor() protoreflect.EnumDescriptor {
+	return file_weather_proto_enumTypes[3].Descriptor()
+}
+
+func (AlertUrgency) Type() protoreflect.EnumType {
+	return &file_weather_proto_enumTypes[3]
+}
+
+func (x AlertUrgency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertUrgency.Descriptor instead.
+func (AlertUrgency) EnumDescriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{3}
+}
+
+type AlertCertainty int32
+
+const (
+	AlertCertainty_UNKNOWN_CERTAINTY AlertCertainty = 0
+	AlertCertainty_OBSERVED          AlertCertainty = 1
+	AlertCertainty_LIKELY            AlertCertainty = 2
+	AlertCertainty_POSSIBLE          AlertCertainty = 3
+	AlertCertainty_UNLIKELY          AlertCertainty = 4
+)
+
+// Enum value maps for AlertCertainty.
+var (
+	AlertCertainty_name = map[int32]string{
+		0: "UNKNOWN_CERTAINTY",
+		1: "OBSERVED",
+		2: "LIKELY",
+		3: "POSSIBLE",
+		4: "UNLIKELY",
+	}
+	AlertCertainty_value = map[string]int32{
+		"UNKNOWN_CERTAINTY": 0,
+		"OBSERVED":          1,
+		"LIKELY":            2,
+		"POSSIBLE":          3,
+		"UNLIKELY":          4,
+	}
+)
+
+func (x AlertCertainty) Enum() *AlertCertainty {
+	p := new(AlertCertainty)
+	*p = x
+	return p
+}
+
+func (x AlertCertainty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertCertainty) Descriptor() protoreflect.EnumDescriptor {
+	return file_weather_proto_enumTypes[4].Descriptor()
+}
+
+func (AlertCertainty) Type() protoreflect.EnumType {
+	return &file_weather_proto_enumTypes[4]
+}
+
+func (x AlertCertainty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertCertainty.Descriptor instead.
+func (AlertCertainty) EnumDescriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{4}
+}
+
+type GeoPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In degrees.
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// In degrees.
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_weather_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// The outer boundary of an area.
+type GeoPolygon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*GeoPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *GeoPolygon) Reset() {
+	*x = GeoPolygon{}
+	mi := &file_weather_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPolygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPolygon) ProtoMessage() {}
+
+func (x *GeoPolygon) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPolygon.ProtoReflect.Descriptor instead.
+func (*GeoPolygon) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeoPolygon) GetPoints() []*GeoPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
 type WeatherCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -116,11 +432,17 @@ type WeatherCondition struct {
 	Visibility int32  `protobuf:"varint,27,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	UvIndex    int32  `protobuf:"varint,28,opt,name=uv_index,json=uvIndex,proto3" json:"uv_index,omitempty"`
 	Summary    string `protobuf:"bytes,29,opt,name=summary,proto3" json:"summary,omitempty"`
+	// A % out of 100. May not be set if the provider doesn't forecast precipitation likelihood.
+	PrecipitationChance int32 `protobuf:"varint,30,opt,name=precipitation_chance,json=precipitationChance,proto3" json:"precipitation_chance,omitempty"`
+	// In Celsius. May not be set if it isn't warm enough for the heat index to apply.
+	HeatIndex float32 `protobuf:"fixed32,31,opt,name=heat_index,json=heatIndex,proto3" json:"heat_index,omitempty"`
+	// In millimetres, the precipitation in the hour before the report. May not be set if the provider doesn't report it.
+	Precipitation float32 `protobuf:"fixed32,32,opt,name=precipitation,proto3" json:"precipitation,omitempty"`
 }
 
 func (x *WeatherCondition) Reset() {
 	*x = WeatherCondition{}
-	mi := &file_weather_proto_msgTypes[0]
+	mi := &file_weather_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -132,7 +454,7 @@ func (x *WeatherCondition) String() string {
 func (*WeatherCondition) ProtoMessage() {}
 
 func (x *WeatherCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[0]
+	mi := &file_weather_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -145,7 +467,7 @@ func (x *WeatherCondition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeatherCondition.ProtoReflect.Descriptor instead.
 func (*WeatherCondition) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{0}
+	return file_weather_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *WeatherCondition) GetSummaryIcon() WeatherIcon {
@@ -218,6 +540,27 @@ func (x *WeatherCondition) GetSummary() string {
 	return ""
 }
 
+func (x *WeatherCondition) GetPrecipitationChance() int32 {
+	if x != nil {
+		return x.PrecipitationChance
+	}
+	return 0
+}
+
+func (x *WeatherCondition) GetHeatIndex() float32 {
+	if x != nil {
+		return x.HeatIndex
+	}
+	return 0
+}
+
+func (x *WeatherCondition) GetPrecipitation() float32 {
+	if x != nil {
+		return x.Precipitation
+	}
+	return 0
+}
+
 type WeatherReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -232,7 +575,7 @@ type WeatherReport struct {
 
 func (x *WeatherReport) Reset() {
 	*x = WeatherReport{}
-	mi := &file_weather_proto_msgTypes[1]
+	mi := &file_weather_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -244,7 +587,7 @@ func (x *WeatherReport) String() string {
 func (*WeatherReport) ProtoMessage() {}
 
 func (x *WeatherReport) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[1]
+	mi := &file_weather_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +600,7 @@ func (x *WeatherReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeatherReport.ProtoReflect.Descriptor instead.
 func (*WeatherReport) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{1}
+	return file_weather_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *WeatherReport) GetObservedAt() *timestamppb.Timestamp {
@@ -309,7 +652,7 @@ type WeatherForecast struct {
 
 func (x *WeatherForecast) Reset() {
 	*x = WeatherForecast{}
-	mi := &file_weather_proto_msgTypes[2]
+	mi := &file_weather_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -321,7 +664,7 @@ func (x *WeatherForecast) String() string {
 func (*WeatherForecast) ProtoMessage() {}
 
 func (x *WeatherForecast) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[2]
+	mi := &file_weather_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +677,7 @@ func (x *WeatherForecast) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeatherForecast.ProtoReflect.Descriptor instead.
 func (*WeatherForecast) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{2}
+	return file_weather_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *WeatherForecast) GetForecastedFor() *timestamppb.Timestamp {
@@ -372,30 +715,49 @@ func (x *WeatherForecast) GetConditions() *WeatherCondition {
 	return nil
 }
 
-type GetCurrentReportRequest struct {
+type WeatherAlert struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
-}
-
-func (x *GetCurrentReportRequest) Reset() {
-	*x = GetCurrentReportRequest{}
-	mi := &file_weather_proto_msgTypes[3]
+	AlertId     string        `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	Severity    AlertSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=faltung.nerves.weather.AlertSeverity" json:"severity,omitempty"`
+	Headline    string        `protobuf:"bytes,3,opt,name=headline,proto3" json:"headline,omitempty"`
+	Description string        `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// A description of the area the alert applies to.
+	Area      string         `protobuf:"bytes,5,opt,name=area,proto3" json:"area,omitempty"`
+	Urgency   AlertUrgency   `protobuf:"varint,6,opt,name=urgency,proto3,enum=faltung.nerves.weather.AlertUrgency" json:"urgency,omitempty"`
+	Certainty AlertCertainty `protobuf:"varint,7,opt,name=certainty,proto3,enum=faltung.nerves.weather.AlertCertainty" json:"certainty,omitempty"`
+	// The type of event the alert is for, i.e. "Wind Advisory".
+	Event string `protobuf:"bytes,8,opt,name=event,proto3" json:"event,omitempty"`
+	// The outer boundary of the area the alert applies to.
+	// May be empty if the provider only describes the area by name.
+	Polygon []*GeoPoint `protobuf:"bytes,9,rep,name=polygon,proto3" json:"polygon,omitempty"`
+	// Set if the area the alert applies to is made up of several separate areas, i.e. a coastline and its islands,
+	// in which case polygon is the first of them.
+	Polygons []*GeoPolygon          `protobuf:"bytes,13,rep,name=polygons,proto3" json:"polygons,omitempty"`
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// May not be set if the provider doesn't publish an expiry time.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// May not be set if the alert is effective as soon as it is issued.
+	EffectiveAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+}
+
+func (x *WeatherAlert) Reset() {
+	*x = WeatherAlert{}
+	mi := &file_weather_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentReportRequest) String() string {
+func (x *WeatherAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentReportRequest) ProtoMessage() {}
+func (*WeatherAlert) ProtoMessage() {}
 
-func (x *GetCurrentReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[3]
+func (x *WeatherAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,49 +768,1153 @@ func (x *GetCurrentReportRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentReportRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentReportRequest) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use WeatherAlert.ProtoReflect.Descriptor instead.
+func (*WeatherAlert) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetCurrentReportRequest) GetLatitude() float64 {
+func (x *WeatherAlert) GetAlertId() string {
 	if x != nil {
-		return x.Latitude
+		return x.AlertId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetCurrentReportRequest) GetLongitude() float64 {
+func (x *WeatherAlert) GetSeverity() AlertSeverity {
 	if x != nil {
-		return x.Longitude
+		return x.Severity
 	}
-	return 0
+	return AlertSeverity_UNKNOWN_SEVERITY
 }
 
-type GetCurrentReportResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Report      *WeatherReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
-	StationName string         `protobuf:"bytes,2,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+func (x *WeatherAlert) GetHeadline() string {
+	if x != nil {
+		return x.Headline
+	}
+	return ""
 }
 
-func (x *GetCurrentReportResponse) Reset() {
-	*x = GetCurrentReportResponse{}
-	mi := &file_weather_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WeatherAlert) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WeatherAlert) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *WeatherAlert) GetUrgency() AlertUrgency {
+	if x != nil {
+		return x.Urgency
+	}
+	return AlertUrgency_UNKNOWN_URGENCY
+}
+
+func (x *WeatherAlert) GetCertainty() AlertCertainty {
+	if x != nil {
+		return x.Certainty
+	}
+	return AlertCertainty_UNKNOWN_CERTAINTY
+}
+
+func (x *WeatherAlert) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WeatherAlert) GetPolygon() []*GeoPoint {
+	if x != nil {
+		return x.Polygon
+	}
+	return nil
+}
+
+func (x *WeatherAlert) GetPolygons() []*GeoPolygon {
+	if x != nil {
+		return x.Polygons
+	}
+	return nil
+}
+
+func (x *WeatherAlert) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *WeatherAlert) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *WeatherAlert) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+// The data retrieved by a station, as saved to disk so it can be restored when weatherd restarts.
+type StationSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StationName string                 `protobuf:"bytes,1,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	Location    *GeoPoint              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Report      *WeatherReport         `protobuf:"bytes,10,opt,name=report,proto3" json:"report,omitempty"`
+	Forecast    []*WeatherForecast     `protobuf:"bytes,11,rep,name=forecast,proto3" json:"forecast,omitempty"`
+	Alerts      []*WeatherAlert        `protobuf:"bytes,12,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	RefreshedAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+	// May not be set if the upstream provider didn't indicate when the data will be out of date.
+	Expires *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=expires,proto3" json:"expires,omitempty"`
+	// Set if some of the data was carried over from an earlier refresh, as refreshed_at is then that of the oldest part.
+	Stale bool `protobuf:"varint,22,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *StationSnapshot) Reset() {
+	*x = StationSnapshot{}
+	mi := &file_weather_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StationSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationSnapshot) ProtoMessage() {}
+
+func (x *StationSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationSnapshot.ProtoReflect.Descriptor instead.
+func (*StationSnapshot) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StationSnapshot) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *StationSnapshot) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *StationSnapshot) GetReport() *WeatherReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *StationSnapshot) GetForecast() []*WeatherForecast {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+func (x *StationSnapshot) GetAlerts() []*WeatherAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *StationSnapshot) GetRefreshedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return nil
+}
+
+func (x *StationSnapshot) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *StationSnapshot) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+// A station which was closer to the requested location than the one which answered, but couldn't be used.
+type SkippedStation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StationName     string    `protobuf:"bytes,1,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	StationLocation *GeoPoint `protobuf:"bytes,2,opt,name=station_location,json=stationLocation,proto3" json:"station_location,omitempty"`
+	// In kilometres, the distance from the requested location to the station.
+	StationDistanceKm float64 `protobuf:"fixed64,3,opt,name=station_distance_km,json=stationDistanceKm,proto3" json:"station_distance_km,omitempty"`
+	// Why the station couldn't be used, i.e. its data has expired.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SkippedStation) Reset() {
+	*x = SkippedStation{}
+	mi := &file_weather_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedStation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedStation) ProtoMessage() {}
+
+func (x *SkippedStation) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedStation.ProtoReflect.Descriptor instead.
+func (*SkippedStation) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SkippedStation) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *SkippedStation) GetStationLocation() *GeoPoint {
+	if x != nil {
+		return x.StationLocation
+	}
+	return nil
+}
+
+func (x *SkippedStation) GetStationDistanceKm() float64 {
+	if x != nil {
+		return x.StationDistanceKm
+	}
+	return 0
+}
+
+func (x *SkippedStation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// A station whose report was blended into an interpolated report.
+type ContributingStation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StationName     string    `protobuf:"bytes,1,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	StationLocation *GeoPoint `protobuf:"bytes,2,opt,name=station_location,json=stationLocation,proto3" json:"station_location,omitempty"`
+	// In kilometres, the distance from the requested location to the station.
+	StationDistanceKm float64 `protobuf:"fixed64,3,opt,name=station_distance_km,json=stationDistanceKm,proto3" json:"station_distance_km,omitempty"`
+	// The fraction of the interpolated values contributed by the station; the weights of every station sum to 1.
+	Weight float64 `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *ContributingStation) Reset() {
+	*x = ContributingStation{}
+	mi := &file_weather_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContributingStation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContributingStation) ProtoMessage() {}
+
+func (x *ContributingStation) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContributingStation.ProtoReflect.Descriptor instead.
+func (*ContributingStation) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ContributingStation) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *ContributingStation) GetStationLocation() *GeoPoint {
+	if x != nil {
+		return x.StationLocation
+	}
+	return nil
+}
+
+func (x *ContributingStation) GetStationDistanceKm() float64 {
+	if x != nil {
+		return x.StationDistanceKm
+	}
+	return 0
+}
+
+func (x *ContributingStation) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type GetCurrentReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// In kilometres, the furthest the station may be from the location. If not set, the server default is used.
+	MaxDistanceKm float64    `protobuf:"fixed64,3,opt,name=max_distance_km,json=maxDistanceKm,proto3" json:"max_distance_km,omitempty"`
+	Mode          ReportMode `protobuf:"varint,4,opt,name=mode,proto3,enum=faltung.nerves.weather.ReportMode" json:"mode,omitempty"`
+	// The number of stations blended in INTERPOLATED mode. If not set, the server default is used.
+	InterpolationStations int32 `protobuf:"varint,5,opt,name=interpolation_stations,json=interpolationStations,proto3" json:"interpolation_stations,omitempty"`
+}
+
+func (x *GetCurrentReportRequest) Reset() {
+	*x = GetCurrentReportRequest{}
+	mi := &file_weather_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentReportRequest) ProtoMessage() {}
+
+func (x *GetCurrentReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentReportRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetCurrentReportRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetCurrentReportRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetCurrentReportRequest) GetMaxDistanceKm() float64 {
+	if x != nil {
+		return x.MaxDistanceKm
+	}
+	return 0
+}
+
+func (x *GetCurrentReportRequest) GetMode() ReportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ReportMode_CLOSEST
+}
+
+func (x *GetCurrentReportRequest) GetInterpolationStations() int32 {
+	if x != nil {
+		return x.InterpolationStations
+	}
+	return 0
+}
+
+type GetCurrentReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report      *WeatherReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	StationName string         `protobuf:"bytes,2,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	// Set if the most recent attempt to refresh the station failed, and older data is being returned.
+	Stale bool `protobuf:"varint,10,opt,name=stale,proto3" json:"stale,omitempty"`
+	// The time since the returned data was retrieved from the upstream provider.
+	Age *durationpb.Duration `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	// Set if weatherd is in offline mode, so the data isn't being refreshed.
+	Offline bool `protobuf:"varint,12,opt,name=offline,proto3" json:"offline,omitempty"`
+	// The location of the station which answered the request.
+	StationLocation *GeoPoint `protobuf:"bytes,20,opt,name=station_location,json=stationLocation,proto3" json:"station_location,omitempty"`
+	// In kilometres, the distance from the requested location to the station.
+	StationDistanceKm float64 `protobuf:"fixed64,21,opt,name=station_distance_km,json=stationDistanceKm,proto3" json:"station_distance_km,omitempty"`
+	// The closer stations which were tried first but couldn't answer the request, closest first.
+	SkippedStations []*SkippedStation `protobuf:"bytes,22,rep,name=skipped_stations,json=skippedStations,proto3" json:"skipped_stations,omitempty"`
+	// In INTERPOLATED mode, the stations the report was blended from, closest first.
+	// The station name, location and distance are those of the closest.
+	ContributingStations []*ContributingStation `protobuf:"bytes,23,rep,name=contributing_stations,json=contributingStations,proto3" json:"contributing_stations,omitempty"`
+}
+
+func (x *GetCurrentReportResponse) Reset() {
+	*x = GetCurrentReportResponse{}
+	mi := &file_weather_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *GetCurrentReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentReportResponse) ProtoMessage() {}
+func (*GetCurrentReportResponse) ProtoMessage() {}
+
+func (x *GetCurrentReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentReportResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentReportResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCurrentReportResponse) GetReport() *WeatherReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *GetCurrentReportResponse) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *GetCurrentReportResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetCurrentReportResponse) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *GetCurrentReportResponse) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *GetCurrentReportResponse) GetStationLocation() *GeoPoint {
+	if x != nil {
+		return x.StationLocation
+	}
+	return nil
+}
+
+func (x *GetCurrentReportResponse) GetStationDistanceKm() float64 {
+	if x != nil {
+		return x.StationDistanceKm
+	}
+	return 0
+}
+
+func (x *GetCurrentReportResponse) GetSkippedStations() []*SkippedStation {
+	if x != nil {
+		return x.SkippedStations
+	}
+	return nil
+}
+
+func (x *GetCurrentReportResponse) GetContributingStations() []*ContributingStation {
+	if x != nil {
+		return x.ContributingStations
+	}
+	return nil
+}
+
+type GetForecastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// In kilometres, the furthest the station may be from the location. If not set, the server default is used.
+	MaxDistanceKm float64 `protobuf:"fixed64,3,opt,name=max_distance_km,json=maxDistanceKm,proto3" json:"max_distance_km,omitempty"`
+}
+
+func (x *GetForecastRequest) Reset() {
+	*x = GetForecastRequest{}
+	mi := &file_weather_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastRequest) ProtoMessage() {}
+
+func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetForecastRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetForecastRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetForecastRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetForecastRequest) GetMaxDistanceKm() float64 {
+	if x != nil {
+		return x.MaxDistanceKm
+	}
+	return 0
+}
+
+type GetForecastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForecastRecords []*WeatherForecast `protobuf:"bytes,1,rep,name=forecast_records,json=forecastRecords,proto3" json:"forecast_records,omitempty"`
+	StationName     string             `protobuf:"bytes,2,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	// Set if the most recent attempt to refresh the station failed, and older data is being returned.
+	Stale bool `protobuf:"varint,10,opt,name=stale,proto3" json:"stale,omitempty"`
+	// The time since the returned data was retrieved from the upstream provider.
+	Age *durationpb.Duration `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	// Set if weatherd is in offline mode, so the data isn't being refreshed.
+	Offline bool `protobuf:"varint,12,opt,name=offline,proto3" json:"offline,omitempty"`
+	// The location of the station which answered the request.
+	StationLocation *GeoPoint `protobuf:"bytes,20,opt,name=station_location,json=stationLocation,proto3" json:"station_location,omitempty"`
+	// In kilometres, the distance from the requested location to the station.
+	StationDistanceKm float64 `protobuf:"fixed64,21,opt,name=station_distance_km,json=stationDistanceKm,proto3" json:"station_distance_km,omitempty"`
+	// The closer stations which were tried first but couldn't answer the request, closest first.
+	SkippedStations []*SkippedStation `protobuf:"bytes,22,rep,name=skipped_stations,json=skippedStations,proto3" json:"skipped_stations,omitempty"`
+}
+
+func (x *GetForecastResponse) Reset() {
+	*x = GetForecastResponse{}
+	mi := &file_weather_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastResponse) ProtoMessage() {}
+
+func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetForecastResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetForecastResponse) GetForecastRecords() []*WeatherForecast {
+	if x != nil {
+		return x.ForecastRecords
+	}
+	return nil
+}
+
+func (x *GetForecastResponse) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *GetForecastResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetForecastResponse) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *GetForecastResponse) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *GetForecastResponse) GetStationLocation() *GeoPoint {
+	if x != nil {
+		return x.StationLocation
+	}
+	return nil
+}
+
+func (x *GetForecastResponse) GetStationDistanceKm() float64 {
+	if x != nil {
+		return x.StationDistanceKm
+	}
+	return 0
+}
+
+func (x *GetForecastResponse) GetSkippedStations() []*SkippedStation {
+	if x != nil {
+		return x.SkippedStations
+	}
+	return nil
+}
+
+type GetAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// In kilometres, the furthest the station may be from the location. If not set, the server default is used.
+	MaxDistanceKm float64 `protobuf:"fixed64,3,opt,name=max_distance_km,json=maxDistanceKm,proto3" json:"max_distance_km,omitempty"`
+}
+
+func (x *GetAlertsRequest) Reset() {
+	*x = GetAlertsRequest{}
+	mi := &file_weather_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertsRequest) ProtoMessage() {}
+
+func (x *GetAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertsRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAlertsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetAlertsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetAlertsRequest) GetMaxDistanceKm() float64 {
+	if x != nil {
+		return x.MaxDistanceKm
+	}
+	return 0
+}
+
+type GetAlertsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alerts      []*WeatherAlert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	StationName string          `protobuf:"bytes,2,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	// Set if the most recent attempt to refresh the station failed, and older data is being returned.
+	Stale bool `protobuf:"varint,10,opt,name=stale,proto3" json:"stale,omitempty"`
+	// The time since the returned data was retrieved from the upstream provider.
+	Age *durationpb.Duration `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	// Set if weatherd is in offline mode, so the data isn't being refreshed.
+	Offline bool `protobuf:"varint,12,opt,name=offline,proto3" json:"offline,omitempty"`
+	// The location of the station which answered the request.
+	StationLocation *GeoPoint `protobuf:"bytes,20,opt,name=station_location,json=stationLocation,proto3" json:"station_location,omitempty"`
+	// In kilometres, the distance from the requested location to the station.
+	StationDistanceKm float64 `protobuf:"fixed64,21,opt,name=station_distance_km,json=stationDistanceKm,proto3" json:"station_distance_km,omitempty"`
+	// The closer stations which were tried first but couldn't answer the request, closest first.
+	SkippedStations []*SkippedStation `protobuf:"bytes,22,rep,name=skipped_stations,json=skippedStations,proto3" json:"skipped_stations,omitempty"`
+}
+
+func (x *GetAlertsResponse) Reset() {
+	*x = GetAlertsResponse{}
+	mi := &file_weather_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertsResponse) ProtoMessage() {}
+
+func (x *GetAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertsResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAlertsResponse) GetAlerts() []*WeatherAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *GetAlertsResponse) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *GetAlertsResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetAlertsResponse) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *GetAlertsResponse) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *GetAlertsResponse) GetStationLocation() *GeoPoint {
+	if x != nil {
+		return x.StationLocation
+	}
+	return nil
+}
+
+func (x *GetAlertsResponse) GetStationDistanceKm() float64 {
+	if x != nil {
+		return x.StationDistanceKm
+	}
+	return 0
+}
+
+func (x *GetAlertsResponse) GetSkippedStations() []*SkippedStation {
+	if x != nil {
+		return x.SkippedStations
+	}
+	return nil
+}
+
+type WatchReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// In kilometres, the furthest the station may be from the location. If not set, the server default is used.
+	MaxDistanceKm float64 `protobuf:"fixed64,3,opt,name=max_distance_km,json=maxDistanceKm,proto3" json:"max_distance_km,omitempty"`
+}
+
+func (x *WatchReportRequest) Reset() {
+	*x = WatchReportRequest{}
+	mi := &file_weather_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReportRequest) ProtoMessage() {}
+
+func (x *WatchReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReportRequest.ProtoReflect.Descriptor instead.
+func (*WatchReportRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchReportRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *WatchReportRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *WatchReportRequest) GetMaxDistanceKm() float64 {
+	if x != nil {
+		return x.MaxDistanceKm
+	}
+	return 0
+}
+
+type WatchReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report      *WeatherReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	StationName string         `protobuf:"bytes,2,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	// Set if the most recent attempt to refresh the station failed, and older data is being returned.
+	Stale bool `protobuf:"varint,10,opt,name=stale,proto3" json:"stale,omitempty"`
+	// The time since the returned data was retrieved from the upstream provider.
+	Age *durationpb.Duration `protobuf:"bytes,11,opt,name=age,proto3" json:"age,omitempty"`
+	// Set if weatherd is in offline mode, so the data isn't being refreshed.
+	Offline bool `protobuf:"varint,12,opt,name=offline,proto3" json:"offline,omitempty"`
+	// The location of the station which answered the request.
+	StationLocation *GeoPoint `protobuf:"bytes,20,opt,name=station_location,json=stationLocation,proto3" json:"station_location,omitempty"`
+	// In kilometres, the distance from the requested location to the station.
+	StationDistanceKm float64 `protobuf:"fixed64,21,opt,name=station_distance_km,json=stationDistanceKm,proto3" json:"station_distance_km,omitempty"`
+}
+
+func (x *WatchReportResponse) Reset() {
+	*x = WatchReportResponse{}
+	mi := &file_weather_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReportResponse) ProtoMessage() {}
+
+func (x *WatchReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReportResponse.ProtoReflect.Descriptor instead.
+func (*WatchReportResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchReportResponse) GetReport() *WeatherReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *WatchReportResponse) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *WatchReportResponse) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *WatchReportResponse) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *WatchReportResponse) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *WatchReportResponse) GetStationLocation() *GeoPoint {
+	if x != nil {
+		return x.StationLocation
+	}
+	return nil
+}
+
+func (x *WatchReportResponse) GetStationDistanceKm() float64 {
+	if x != nil {
+		return x.StationDistanceKm
+	}
+	return 0
+}
+
+type GetHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Reports observed at or after this time are returned.
+	Start *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	// Reports observed before this time are returned. Defaults to the current time if not set.
+	End *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// In kilometres, the furthest the station may be from the location. If not set, the server default is used.
+	MaxDistanceKm float64 `protobuf:"fixed64,5,opt,name=max_distance_km,json=maxDistanceKm,proto3" json:"max_distance_km,omitempty"`
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_weather_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetHistoryRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetHistoryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetHistoryRequest) GetMaxDistanceKm() float64 {
+	if x != nil {
+		return x.MaxDistanceKm
+	}
+	return 0
+}
+
+type GetHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sorted by the time they were observed, oldest first.
+	Reports     []*WeatherReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	StationName string           `protobuf:"bytes,2,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	// The location of the station which answered the request.
+	StationLocation *GeoPoint `protobuf:"bytes,20,opt,name=station_location,json=stationLocation,proto3" json:"station_location,omitempty"`
+	// In kilometres, the distance from the requested location to the station.
+	StationDistanceKm float64 `protobuf:"fixed64,21,opt,name=station_distance_km,json=stationDistanceKm,proto3" json:"station_distance_km,omitempty"`
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	mi := &file_weather_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
 
-func (x *GetCurrentReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[4]
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,49 +1925,194 @@ func (x *GetCurrentReportResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentReportResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentReportResponse) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetCurrentReportResponse) GetReport() *WeatherReport {
+func (x *GetHistoryResponse) GetReports() []*WeatherReport {
 	if x != nil {
-		return x.Report
+		return x.Reports
 	}
 	return nil
 }
 
-func (x *GetCurrentReportResponse) GetStationName() string {
+func (x *GetHistoryResponse) GetStationName() string {
 	if x != nil {
 		return x.StationName
 	}
 	return ""
 }
 
-type GetForecastRequest struct {
+func (x *GetHistoryResponse) GetStationLocation() *GeoPoint {
+	if x != nil {
+		return x.StationLocation
+	}
+	return nil
+}
+
+func (x *GetHistoryResponse) GetStationDistanceKm() float64 {
+	if x != nil {
+		return x.StationDistanceKm
+	}
+	return 0
+}
+
+// The conditions reported by a station over a period of time.
+type WeatherSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// The number of reports the summary is calculated from.
+	ReportCount int32 `protobuf:"varint,3,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+	// In Celsius. Reports with a temperature of zero are treated as not having one, and excluded from the temperatures.
+	MinTemperature float32 `protobuf:"fixed32,10,opt,name=min_temperature,json=minTemperature,proto3" json:"min_temperature,omitempty"`
+	// In Celsius.
+	MaxTemperature float32 `protobuf:"fixed32,11,opt,name=max_temperature,json=maxTemperature,proto3" json:"max_temperature,omitempty"`
+	// In Celsius.
+	MeanTemperature float32 `protobuf:"fixed32,12,opt,name=mean_temperature,json=meanTemperature,proto3" json:"mean_temperature,omitempty"`
+	// In km/hr
+	MaxWindSpeed int32 `protobuf:"varint,13,opt,name=max_wind_speed,json=maxWindSpeed,proto3" json:"max_wind_speed,omitempty"`
+	// In millimetres.
+	TotalPrecipitation float32 `protobuf:"fixed32,14,opt,name=total_precipitation,json=totalPrecipitation,proto3" json:"total_precipitation,omitempty"`
+	// The condition reported most often over the period.
+	DominantIcon    WeatherIcon `protobuf:"varint,15,opt,name=dominant_icon,json=dominantIcon,proto3,enum=faltung.nerves.weather.WeatherIcon" json:"dominant_icon,omitempty"`
+	DominantSummary string      `protobuf:"bytes,16,opt,name=dominant_summary,json=dominantSummary,proto3" json:"dominant_summary,omitempty"`
+}
+
+func (x *WeatherSummary) Reset() {
+	*x = WeatherSummary{}
+	mi := &file_weather_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeatherSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeatherSummary) ProtoMessage() {}
+
+func (x *WeatherSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeatherSummary.ProtoReflect.Descriptor instead.
+func (*WeatherSummary) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WeatherSummary) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *WeatherSummary) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *WeatherSummary) GetReportCount() int32 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+func (x *WeatherSummary) GetMinTemperature() float32 {
+	if x != nil {
+		return x.MinTemperature
+	}
+	return 0
+}
+
+func (x *WeatherSummary) GetMaxTemperature() float32 {
+	if x != nil {
+		return x.MaxTemperature
+	}
+	return 0
+}
+
+func (x *WeatherSummary) GetMeanTemperature() float32 {
+	if x != nil {
+		return x.MeanTemperature
+	}
+	return 0
+}
+
+func (x *WeatherSummary) GetMaxWindSpeed() int32 {
+	if x != nil {
+		return x.MaxWindSpeed
+	}
+	return 0
+}
+
+func (x *WeatherSummary) GetTotalPrecipitation() float32 {
+	if x != nil {
+		return x.TotalPrecipitation
+	}
+	return 0
+}
+
+func (x *WeatherSummary) GetDominantIcon() WeatherIcon {
+	if x != nil {
+		return x.DominantIcon
+	}
+	return WeatherIcon_SUNNY
+}
+
+func (x *WeatherSummary) GetDominantSummary() string {
+	if x != nil {
+		return x.DominantSummary
+	}
+	return ""
+}
+
+type GetSummaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
 	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// The period including this time is the first summarized.
+	Start *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	// Periods starting before this time are summarized. Defaults to the current time if not set.
+	End *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// In kilometres, the furthest the station may be from the location. If not set, the server default is used.
+	MaxDistanceKm float64 `protobuf:"fixed64,5,opt,name=max_distance_km,json=maxDistanceKm,proto3" json:"max_distance_km,omitempty"`
 }
 
-func (x *GetForecastRequest) Reset() {
-	*x = GetForecastRequest{}
-	mi := &file_weather_proto_msgTypes[5]
+func (x *GetSummaryRequest) Reset() {
+	*x = GetSummaryRequest{}
+	mi := &file_weather_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForecastRequest) String() string {
+func (x *GetSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForecastRequest) ProtoMessage() {}
+func (*GetSummaryRequest) ProtoMessage() {}
 
-func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[5]
+func (x *GetSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,48 +2123,77 @@ func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForecastRequest.ProtoReflect.Descriptor instead.
-func (*GetForecastRequest) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetForecastRequest) GetLatitude() float64 {
+func (x *GetSummaryRequest) GetLatitude() float64 {
 	if x != nil {
 		return x.Latitude
 	}
 	return 0
 }
 
-func (x *GetForecastRequest) GetLongitude() float64 {
+func (x *GetSummaryRequest) GetLongitude() float64 {
 	if x != nil {
 		return x.Longitude
 	}
 	return 0
 }
 
-type GetForecastResponse struct {
+func (x *GetSummaryRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetSummaryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetSummaryRequest) GetMaxDistanceKm() float64 {
+	if x != nil {
+		return x.MaxDistanceKm
+	}
+	return 0
+}
+
+type GetSummaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ForecastRecords []*WeatherForecast `protobuf:"bytes,1,rep,name=forecast_records,json=forecastRecords,proto3" json:"forecast_records,omitempty"`
+	// Sorted by their start time. Periods without any recorded reports are omitted.
+	Summaries   []*WeatherSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries,omitempty"`
+	StationName string            `protobuf:"bytes,2,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	// The time zone the periods are aligned to, i.e. "America/Toronto".
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The location of the station which answered the request.
+	StationLocation *GeoPoint `protobuf:"bytes,20,opt,name=station_location,json=stationLocation,proto3" json:"station_location,omitempty"`
+	// In kilometres, the distance from the requested location to the station.
+	StationDistanceKm float64 `protobuf:"fixed64,21,opt,name=station_distance_km,json=stationDistanceKm,proto3" json:"station_distance_km,omitempty"`
 }
 
-func (x *GetForecastResponse) Reset() {
-	*x = GetForecastResponse{}
-	mi := &file_weather_proto_msgTypes[6]
+func (x *GetSummaryResponse) Reset() {
+	*x = GetSummaryResponse{}
+	mi := &file_weather_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForecastResponse) String() string {
+func (x *GetSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForecastResponse) ProtoMessage() {}
+func (*GetSummaryResponse) ProtoMessage() {}
 
-func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_weather_proto_msgTypes[6]
+func (x *GetSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,142 +2204,651 @@ func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForecastResponse.ProtoReflect.Descriptor instead.
-func (*GetForecastResponse) Descriptor() ([]byte, []int) {
-	return file_weather_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetForecastResponse) GetForecastRecords() []*WeatherForecast {
+func (x *GetSummaryResponse) GetSummaries() []*WeatherSummary {
 	if x != nil {
-		return x.ForecastRecords
+		return x.Summaries
+	}
+	return nil
+}
+
+func (x *GetSummaryResponse) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *GetSummaryResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetSummaryResponse) GetStationLocation() *GeoPoint {
+	if x != nil {
+		return x.StationLocation
 	}
 	return nil
 }
 
+func (x *GetSummaryResponse) GetStationDistanceKm() float64 {
+	if x != nil {
+		return x.StationDistanceKm
+	}
+	return 0
+}
+
+type SetOfflineModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offline bool `protobuf:"varint,1,opt,name=offline,proto3" json:"offline,omitempty"`
+}
+
+func (x *SetOfflineModeRequest) Reset() {
+	*x = SetOfflineModeRequest{}
+	mi := &file_weather_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOfflineModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOfflineModeRequest) ProtoMessage() {}
+
+func (x *SetOfflineModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOfflineModeRequest.ProtoReflect.Descriptor instead.
+func (*SetOfflineModeRequest) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetOfflineModeRequest) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+type SetOfflineModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offline bool `protobuf:"varint,1,opt,name=offline,proto3" json:"offline,omitempty"`
+}
+
+func (x *SetOfflineModeResponse) Reset() {
+	*x = SetOfflineModeResponse{}
+	mi := &file_weather_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOfflineModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOfflineModeResponse) ProtoMessage() {}
+
+func (x *SetOfflineModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_weather_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOfflineModeResponse.ProtoReflect.Descriptor instead.
+func (*SetOfflineModeResponse) Descriptor() ([]byte, []int) {
+	return file_weather_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetOfflineModeResponse) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
 var File_weather_proto protoreflect.FileDescriptor
 
 var file_weather_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x16, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e,
-	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x02, 0x0a, 0x10, 0x57, 0x65, 0x61,
-	0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a,
-	0x0c, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65,
-	0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61,
-	0x74, 0x68, 0x65, 0x72, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x5f,
-	0x63, 0x68, 0x69, 0x6c, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x77, 0x69, 0x6e,
-	0x64, 0x43, 0x68, 0x69, 0x6c, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x77, 0x5f, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x65, 0x77, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18,
-	0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77,
-	0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x77, 0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69,
-	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x76,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x76,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22,
-	0xb3, 0x02, 0x0a, 0x0d, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25,
-	0x0a, 0x0e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73,
-	0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb5, 0x02, 0x0a, 0x0f, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
-	0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x66, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x66,
-	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
-	0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x44, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x46,
+	0x0a, 0x0a, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66,
+	0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xdc, 0x03, 0x0a, 0x10, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0c, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x49,
+	0x63, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x63, 0x68,
+	0x69, 0x6c, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x43,
+	0x68, 0x69, 0x6c, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x77, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x65, 0x77, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e,
+	0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77,
+	0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x76, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x76, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x31, 0x0a,
+	0x14, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x70, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x68, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x24, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb3, 0x02, 0x0a, 0x0d, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb5, 0x02, 0x0a, 0x0f,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12,
+	0x41, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x65, 0x64, 0x46,
+	0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x89, 0x05, 0x0a, 0x0c, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x41, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x72, 0x65, 0x61, 0x12, 0x3e, 0x0a, 0x07, 0x75, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x55, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x07, 0x75, 0x72, 0x67,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x44, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e,
 	0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
-	0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x53, 0x0a,
+	0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x52,
+	0x09, 0x63, 0x65, 0x72, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x3a, 0x0a, 0x07, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08,
+	0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x6c, 0x79, 0x67,
+	0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x09,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x3d, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x74, 0x22,
+	0xbf, 0x03, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75,
+	0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x08,
+	0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75,
+	0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06,
+	0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x22, 0xc8, 0x01, 0x0a, 0x0e, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x4b, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xcd, 0x01, 0x0a,
+	0x13, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x4b, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xea, 0x01, 0x0a,
 	0x17, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
 	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
 	0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
 	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
-	0x64, 0x65, 0x22, 0x7c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
-	0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e,
-	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x4e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
-	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x22, 0x69, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52,
+	0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x36, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75,
+	0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x15, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x04, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e,
+	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x2b,
+	0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73,
+	0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4b, 0x6d, 0x12, 0x51, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66,
+	0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x60, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x22,
+	0xb9, 0x03, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x63,
 	0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x27, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76,
 	0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68,
 	0x65, 0x72, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x65,
-	0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2a, 0xb7, 0x01, 0x0a, 0x0b,
-	0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x53,
-	0x55, 0x4e, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x59,
-	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f,
-	0x43, 0x4c, 0x4f, 0x55, 0x44, 0x59, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x53, 0x54,
-	0x4c, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x59, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x52,
-	0x41, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x4e, 0x43, 0x45, 0x5f,
-	0x4f, 0x46, 0x5f, 0x52, 0x41, 0x49, 0x4e, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4e, 0x4f,
-	0x57, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4f, 0x46,
-	0x5f, 0x53, 0x4e, 0x4f, 0x57, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x4e, 0x4f, 0x57, 0x5f,
-	0x53, 0x48, 0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x48, 0x55,
-	0x4e, 0x44, 0x45, 0x52, 0x53, 0x54, 0x4f, 0x52, 0x4d, 0x53, 0x10, 0x09, 0x12, 0x07, 0x0a, 0x03,
-	0x46, 0x4f, 0x47, 0x10, 0x0a, 0x32, 0xf3, 0x01, 0x0a, 0x0e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x2e, 0x66,
+	0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x51, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x74, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b,
+	0x6d, 0x22, 0xa1, 0x03, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e,
+	0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x2e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x2b,
+	0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73,
+	0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4b, 0x6d, 0x12, 0x51, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66,
 	0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65,
-	0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x22, 0xd1, 0x02,
+	0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x2b, 0x0a,
+	0x03, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b,
+	0x6d, 0x22, 0xd5, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x22, 0xf5, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e,
+	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b,
+	0x6d, 0x22, 0xdc, 0x03, 0x0a, 0x0e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x61,
+	0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x57, 0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x64,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6e,
+	0x74, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6e, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x22, 0xd5, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x6b, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x22, 0x97, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x09, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x09, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73,
+	0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4b, 0x6d, 0x22, 0x31, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x32, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c,
+	0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x2a, 0xb7, 0x01, 0x0a, 0x0b, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x55, 0x4e,
+	0x4e, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x59, 0x10, 0x01,
+	0x12, 0x14, 0x0a, 0x10, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x43, 0x4c,
+	0x4f, 0x55, 0x44, 0x59, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x53, 0x54, 0x4c, 0x59,
+	0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x59, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x41, 0x49,
+	0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4f, 0x46,
+	0x5f, 0x52, 0x41, 0x49, 0x4e, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4e, 0x4f, 0x57, 0x10,
+	0x06, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x53,
+	0x4e, 0x4f, 0x57, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x4e, 0x4f, 0x57, 0x5f, 0x53, 0x48,
+	0x4f, 0x57, 0x45, 0x52, 0x53, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x48, 0x55, 0x4e, 0x44,
+	0x45, 0x52, 0x53, 0x54, 0x4f, 0x52, 0x4d, 0x53, 0x10, 0x09, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f,
+	0x47, 0x10, 0x0a, 0x2a, 0x2b, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x10,
+	0x0a, 0x0c, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x4f, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x2a, 0x57, 0x0a, 0x0d, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x45, 0x56,
+	0x45, 0x52, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x4e, 0x4f, 0x52,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x56, 0x45, 0x52, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x45, 0x58, 0x54, 0x52, 0x45, 0x4d, 0x45, 0x10, 0x04, 0x2a, 0x56, 0x0a, 0x0c, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x55, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x55, 0x52, 0x47, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x53, 0x54, 0x10,
+	0x04, 0x2a, 0x5d, 0x0a, 0x0e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x65, 0x72, 0x74, 0x61, 0x69,
+	0x6e, 0x74, 0x79, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x43,
+	0x45, 0x52, 0x54, 0x41, 0x49, 0x4e, 0x54, 0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x42,
+	0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x49, 0x4b, 0x45,
+	0x4c, 0x59, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x53, 0x53, 0x49, 0x42, 0x4c, 0x45,
+	0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x4e, 0x4c, 0x49, 0x4b, 0x45, 0x4c, 0x59, 0x10, 0x04,
+	0x32, 0x83, 0x06, 0x0a, 0x0e, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e,
+	0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75,
+	0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x66, 0x61,
+	0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e,
+	0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65,
+	0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
 	0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77,
-	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x68, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
-	0x12, 0x2a, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65,
-	0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x66,
-	0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65,
-	0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1f, 0x5a, 0x1d, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6d, 0x72, 0x6f, 0x62, 0x69,
-	0x6e, 0x73, 0x6f, 0x6e, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0b, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2a, 0x2e, 0x66, 0x61, 0x6c, 0x74,
+	0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x65, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73,
+	0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x29, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65,
+	0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x66, 0x61,
+	0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x29, 0x2e,
+	0x66, 0x61, 0x6c, 0x74, 0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77,
+	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75,
+	0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x81, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x66, 0x61, 0x6c, 0x74,
+	0x75, 0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x66, 0x61, 0x6c, 0x74, 0x75,
+	0x6e, 0x67, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x65, 0x73, 0x2e, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6d, 0x72, 0x6f, 0x62, 0x69, 0x6e,
+	0x73, 0x6f, 0x6e, 0x2f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -714,40 +2863,117 @@ func file_weather_proto_rawDescGZIP() []byte {
 	return file_weather_proto_rawDescData
 }
 
-var file_weather_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_weather_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_weather_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_weather_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_weather_proto_goTypes = []any{
 	(WeatherIcon)(0),                 // 0: faltung.nerves.weather.WeatherIcon
-	(*WeatherCondition)(nil),         // 1: faltung.nerves.weather.WeatherCondition
-	(*WeatherReport)(nil),            // 2: faltung.nerves.weather.WeatherReport
-	(*WeatherForecast)(nil),          // 3: faltung.nerves.weather.WeatherForecast
-	(*GetCurrentReportRequest)(nil),  // 4: faltung.nerves.weather.GetCurrentReportRequest
-	(*GetCurrentReportResponse)(nil), // 5: faltung.nerves.weather.GetCurrentReportResponse
-	(*GetForecastRequest)(nil),       // 6: faltung.nerves.weather.GetForecastRequest
-	(*GetForecastResponse)(nil),      // 7: faltung.nerves.weather.GetForecastResponse
-	(*timestamppb.Timestamp)(nil),    // 8: google.protobuf.Timestamp
+	(ReportMode)(0),                  // 1: faltung.nerves.weather.ReportMode
+	(AlertSeverity)(0),               // 2: faltung.nerves.weather.AlertSeverity
+	(AlertUrgency)(0),                // 3: faltung.nerves.weather.AlertUrgency
+	(AlertCertainty)(0),              // 4: faltung.nerves.weather.AlertCertainty
+	(*GeoPoint)(nil),                 // 5: faltung.nerves.weather.GeoPoint
+	(*GeoPolygon)(nil),               // 6: faltung.nerves.weather.GeoPolygon
+	(*WeatherCondition)(nil),         // 7: faltung.nerves.weather.WeatherCondition
+	(*WeatherReport)(nil),            // 8: faltung.nerves.weather.WeatherReport
+	(*WeatherForecast)(nil),          // 9: faltung.nerves.weather.WeatherForecast
+	(*WeatherAlert)(nil),             // 10: faltung.nerves.weather.WeatherAlert
+	(*StationSnapshot)(nil),          // 11: faltung.nerves.weather.StationSnapshot
+	(*SkippedStation)(nil),           // 12: faltung.nerves.weather.SkippedStation
+	(*ContributingStation)(nil),      // 13: faltung.nerves.weather.ContributingStation
+	(*GetCurrentReportRequest)(nil),  // 14: faltung.nerves.weather.GetCurrentReportRequest
+	(*GetCurrentReportResponse)(nil), // 15: faltung.nerves.weather.GetCurrentReportResponse
+	(*GetForecastRequest)(nil),       // 16: faltung.nerves.weather.GetForecastRequest
+	(*GetForecastResponse)(nil),      // 17: faltung.nerves.weather.GetForecastResponse
+	(*GetAlertsRequest)(nil),         // 18: faltung.nerves.weather.GetAlertsRequest
+	(*GetAlertsResponse)(nil),        // 19: faltung.nerves.weather.GetAlertsResponse
+	(*WatchReportRequest)(nil),       // 20: faltung.nerves.weather.WatchReportRequest
+	(*WatchReportResponse)(nil),      // 21: faltung.nerves.weather.WatchReportResponse
+	(*GetHistoryRequest)(nil),        // 22: faltung.nerves.weather.GetHistoryRequest
+	(*GetHistoryResponse)(nil),       // 23: faltung.nerves.weather.GetHistoryResponse
+	(*WeatherSummary)(nil),           // 24: faltung.nerves.weather.WeatherSummary
+	(*GetSummaryRequest)(nil),        // 25: faltung.nerves.weather.GetSummaryRequest
+	(*GetSummaryResponse)(nil),       // 26: faltung.nerves.weather.GetSummaryResponse
+	(*SetOfflineModeRequest)(nil),    // 27: faltung.nerves.weather.SetOfflineModeRequest
+	(*SetOfflineModeResponse)(nil),   // 28: faltung.nerves.weather.SetOfflineModeResponse
+	(*timestamppb.Timestamp)(nil),    // 29: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),      // 30: google.protobuf.Duration
 }
 var file_weather_proto_depIdxs = []int32{
-	0,  // 0: faltung.nerves.weather.WeatherCondition.summary_icon:type_name -> faltung.nerves.weather.WeatherIcon
-	8,  // 1: faltung.nerves.weather.WeatherReport.observed_at:type_name -> google.protobuf.Timestamp
-	8,  // 2: faltung.nerves.weather.WeatherReport.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 3: faltung.nerves.weather.WeatherReport.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 4: faltung.nerves.weather.WeatherReport.conditions:type_name -> faltung.nerves.weather.WeatherCondition
-	8,  // 5: faltung.nerves.weather.WeatherForecast.forecasted_for:type_name -> google.protobuf.Timestamp
-	8,  // 6: faltung.nerves.weather.WeatherForecast.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 7: faltung.nerves.weather.WeatherForecast.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 8: faltung.nerves.weather.WeatherForecast.conditions:type_name -> faltung.nerves.weather.WeatherCondition
-	2,  // 9: faltung.nerves.weather.GetCurrentReportResponse.report:type_name -> faltung.nerves.weather.WeatherReport
-	3,  // 10: faltung.nerves.weather.GetForecastResponse.forecast_records:type_name -> faltung.nerves.weather.WeatherForecast
-	4,  // 11: faltung.nerves.weather.WeatherService.GetCurrentReport:input_type -> faltung.nerves.weather.GetCurrentReportRequest
-	6,  // 12: faltung.nerves.weather.WeatherService.GetForecast:input_type -> faltung.nerves.weather.GetForecastRequest
-	5,  // 13: faltung.nerves.weather.WeatherService.GetCurrentReport:output_type -> faltung.nerves.weather.GetCurrentReportResponse
-	7,  // 14: faltung.nerves.weather.WeatherService.GetForecast:output_type -> faltung.nerves.weather.GetForecastResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	5,  // 0: faltung.nerves.weather.GeoPolygon.points:type_name -> faltung.nerves.weather.GeoPoint
+	0,  // 1: faltung.nerves.weather.WeatherCondition.summary_icon:type_name -> faltung.nerves.weather.WeatherIcon
+	29, // 2: faltung.nerves.weather.WeatherReport.observed_at:type_name -> google.protobuf.Timestamp
+	29, // 3: faltung.nerves.weather.WeatherReport.created_at:type_name -> google.protobuf.Timestamp
+	29, // 4: faltung.nerves.weather.WeatherReport.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: faltung.nerves.weather.WeatherReport.conditions:type_name -> faltung.nerves.weather.WeatherCondition
+	29, // 6: faltung.nerves.weather.WeatherForecast.forecasted_for:type_name -> google.protobuf.Timestamp
+	29, // 7: faltung.nerves.weather.WeatherForecast.created_at:type_name -> google.protobuf.Timestamp
+	29, // 8: faltung.nerves.weather.WeatherForecast.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: faltung.nerves.weather.WeatherForecast.conditions:type_name -> faltung.nerves.weather.WeatherCondition
+	2,  // 10: faltung.nerves.weather.WeatherAlert.severity:type_name -> faltung.nerves.weather.AlertSeverity
+	3,  // 11: faltung.nerves.weather.WeatherAlert.urgency:type_name -> faltung.nerves.weather.AlertUrgency
+	4,  // 12: faltung.nerves.weather.WeatherAlert.certainty:type_name -> faltung.nerves.weather.AlertCertainty
+	5,  // 13: faltung.nerves.weather.WeatherAlert.polygon:type_name -> faltung.nerves.weather.GeoPoint
+	6,  // 14: faltung.nerves.weather.WeatherAlert.polygons:type_name -> faltung.nerves.weather.GeoPolygon
+	29, // 15: faltung.nerves.weather.WeatherAlert.issued_at:type_name -> google.protobuf.Timestamp
+	29, // 16: faltung.nerves.weather.WeatherAlert.expires_at:type_name -> google.protobuf.Timestamp
+	29, // 17: faltung.nerves.weather.WeatherAlert.effective_at:type_name -> google.protobuf.Timestamp
+	5,  // 18: faltung.nerves.weather.StationSnapshot.location:type_name -> faltung.nerves.weather.GeoPoint
+	8,  // 19: faltung.nerves.weather.StationSnapshot.report:type_name -> faltung.nerves.weather.WeatherReport
+	9,  // 20: faltung.nerves.weather.StationSnapshot.forecast:type_name -> faltung.nerves.weather.WeatherForecast
+	10, // 21: faltung.nerves.weather.StationSnapshot.alerts:type_name -> faltung.nerves.weather.WeatherAlert
+	29, // 22: faltung.nerves.weather.StationSnapshot.refreshed_at:type_name -> google.protobuf.Timestamp
+	29, // 23: faltung.nerves.weather.StationSnapshot.expires:type_name -> google.protobuf.Timestamp
+	5,  // 24: faltung.nerves.weather.SkippedStation.station_location:type_name -> faltung.nerves.weather.GeoPoint
+	5,  // 25: faltung.nerves.weather.ContributingStation.station_location:type_name -> faltung.nerves.weather.GeoPoint
+	1,  // 26: faltung.nerves.weather.GetCurrentReportRequest.mode:type_name -> faltung.nerves.weather.ReportMode
+	8,  // 27: faltung.nerves.weather.GetCurrentReportResponse.report:type_name -> faltung.nerves.weather.WeatherReport
+	30, // 28: faltung.nerves.weather.GetCurrentReportResponse.age:type_name -> google.protobuf.Duration
+	5,  // 29: faltung.nerves.weather.GetCurrentReportResponse.station_location:type_name -> faltung.nerves.weather.GeoPoint
+	12, // 30: faltung.nerves.weather.GetCurrentReportResponse.skipped_stations:type_name -> faltung.nerves.weather.SkippedStation
+	13, // 31: faltung.nerves.weather.GetCurrentReportResponse.contributing_stations:type_name -> faltung.nerves.weather.ContributingStation
+	9,  // 32: faltung.nerves.weather.GetForecastResponse.forecast_records:type_name -> faltung.nerves.weather.WeatherForecast
+	30, // 33: faltung.nerves.weather.GetForecastResponse.age:type_name -> google.protobuf.Duration
+	5,  // 34: faltung.nerves.weather.GetForecastResponse.station_location:type_name -> faltung.nerves.weather.GeoPoint
+	12, // 35: faltung.nerves.weather.GetForecastResponse.skipped_stations:type_name -> faltung.nerves.weather.SkippedStation
+	10, // 36: faltung.nerves.weather.GetAlertsResponse.alerts:type_name -> faltung.nerves.weather.WeatherAlert
+	30, // 37: faltung.nerves.weather.GetAlertsResponse.age:type_name -> google.protobuf.Duration
+	5,  // 38: faltung.nerves.weather.GetAlertsResponse.station_location:type_name -> faltung.nerves.weather.GeoPoint
+	12, // 39: faltung.nerves.weather.GetAlertsResponse.skipped_stations:type_name -> faltung.nerves.weather.SkippedStation
+	8,  // 40: faltung.nerves.weather.WatchReportResponse.report:type_name -> faltung.nerves.weather.WeatherReport
+	30, // 41: faltung.nerves.weather.WatchReportResponse.age:type_name -> google.protobuf.Duration
+	5,  // 42: faltung.nerves.weather.WatchReportResponse.station_location:type_name -> faltung.nerves.weather.GeoPoint
+	29, // 43: faltung.nerves.weather.GetHistoryRequest.start:type_name -> google.protobuf.Timestamp
+	29, // 44: faltung.nerves.weather.GetHistoryRequest.end:type_name -> google.protobuf.Timestamp
+	8,  // 45: faltung.nerves.weather.GetHistoryResponse.reports:type_name -> faltung.nerves.weather.WeatherReport
+	5,  // 46: faltung.nerves.weather.GetHistoryResponse.station_location:type_name -> faltung.nerves.weather.GeoPoint
+	29, // 47: faltung.nerves.weather.WeatherSummary.start:type_name -> google.protobuf.Timestamp
+	29, // 48: faltung.nerves.weather.WeatherSummary.end:type_name -> google.protobuf.Timestamp
+	0,  // 49: faltung.nerves.weather.WeatherSummary.dominant_icon:type_name -> faltung.nerves.weather.WeatherIcon
+	29, // 50: faltung.nerves.weather.GetSummaryRequest.start:type_name -> google.protobuf.Timestamp
+	29, // 51: faltung.nerves.weather.GetSummaryRequest.end:type_name -> google.protobuf.Timestamp
+	24, // 52: faltung.nerves.weather.GetSummaryResponse.summaries:type_name -> faltung.nerves.weather.WeatherSummary
+	5,  // 53: faltung.nerves.weather.GetSummaryResponse.station_location:type_name -> faltung.nerves.weather.GeoPoint
+	14, // 54: faltung.nerves.weather.WeatherService.GetCurrentReport:input_type -> faltung.nerves.weather.GetCurrentReportRequest
+	16, // 55: faltung.nerves.weather.WeatherService.GetForecast:input_type -> faltung.nerves.weather.GetForecastRequest
+	18, // 56: faltung.nerves.weather.WeatherService.GetAlerts:input_type -> faltung.nerves.weather.GetAlertsRequest
+	20, // 57: faltung.nerves.weather.WeatherService.WatchReport:input_type -> faltung.nerves.weather.WatchReportRequest
+	22, // 58: faltung.nerves.weather.WeatherService.GetHistory:input_type -> faltung.nerves.weather.GetHistoryRequest
+	25, // 59: faltung.nerves.weather.WeatherService.GetDailySummary:input_type -> faltung.nerves.weather.GetSummaryRequest
+	25, // 60: faltung.nerves.weather.WeatherService.GetHourlySummary:input_type -> faltung.nerves.weather.GetSummaryRequest
+	27, // 61: faltung.nerves.weather.AdminService.SetOfflineMode:input_type -> faltung.nerves.weather.SetOfflineModeRequest
+	15, // 62: faltung.nerves.weather.WeatherService.GetCurrentReport:output_type -> faltung.nerves.weather.GetCurrentReportResponse
+	17, // 63: faltung.nerves.weather.WeatherService.GetForecast:output_type -> faltung.nerves.weather.GetForecastResponse
+	19, // 64: faltung.nerves.weather.WeatherService.GetAlerts:output_type -> faltung.nerves.weather.GetAlertsResponse
+	21, // 65: faltung.nerves.weather.WeatherService.WatchReport:output_type -> faltung.nerves.weather.WatchReportResponse
+	23, // 66: faltung.nerves.weather.WeatherService.GetHistory:output_type -> faltung.nerves.weather.GetHistoryResponse
+	26, // 67: faltung.nerves.weather.WeatherService.GetDailySummary:output_type -> faltung.nerves.weather.GetSummaryResponse
+	26, // 68: faltung.nerves.weather.WeatherService.GetHourlySummary:output_type -> faltung.nerves.weather.GetSummaryResponse
+	28, // 69: faltung.nerves.weather.AdminService.SetOfflineMode:output_type -> faltung.nerves.weather.SetOfflineModeResponse
+	62, // [62:70] is the sub-list for method output_type
+	54, // [54:62] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_weather_proto_init() }
@@ -760,10 +2986,10 @@ func file_weather_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_weather_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   24,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_weather_proto_goTypes,
 		DependencyIndexes: file_weather_proto_depIdxs,
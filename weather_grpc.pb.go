@@ -21,6 +21,11 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	WeatherService_GetCurrentReport_FullMethodName = "/faltung.nerves.weather.WeatherService/GetCurrentReport"
 	WeatherService_GetForecast_FullMethodName      = "/faltung.nerves.weather.WeatherService/GetForecast"
+	WeatherService_GetAlerts_FullMethodName        = "/faltung.nerves.weather.WeatherService/GetAlerts"
+	WeatherService_WatchReport_FullMethodName      = "/faltung.nerves.weather.WeatherService/WatchReport"
+	WeatherService_GetHistory_FullMethodName       = "/faltung.nerves.weather.WeatherService/GetHistory"
+	WeatherService_GetDailySummary_FullMethodName  = "/faltung.nerves.weather.WeatherService/GetDailySummary"
+	WeatherService_GetHourlySummary_FullMethodName = "/faltung.nerves.weather.WeatherService/GetHourlySummary"
 )
 
 // WeatherServiceClient is the client API for WeatherService service.
@@ -29,6 +34,15 @@ const (
 type WeatherServiceClient interface {
 	GetCurrentReport(ctx context.Context, in *GetCurrentReportRequest, opts ...grpc.CallOption) (*GetCurrentReportResponse, error)
 	GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error)
+	GetAlerts(ctx context.Context, in *GetAlertsRequest, opts ...grpc.CallOption) (*GetAlertsResponse, error)
+	// WatchReport streams a new report each time the closest station publishes a new observation.
+	WatchReport(ctx context.Context, in *WatchReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchReportResponse], error)
+	// GetHistory returns the reports recorded by the closest station over a period of time.
+	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	// GetDailySummary summarizes the reports recorded by the closest station for each day in its local time zone.
+	GetDailySummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (*GetSummaryResponse, error)
+	// GetHourlySummary summarizes the reports recorded by the closest station for each hour in its local time zone.
+	GetHourlySummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (*GetSummaryResponse, error)
 }
 
 type weatherServiceClient struct {
@@ -59,12 +73,80 @@ func (c *weatherServiceClient) GetForecast(ctx context.Context, in *GetForecastR
 	return out, nil
 }
 
+func (c *weatherServiceClient) GetAlerts(ctx context.Context, in *GetAlertsRequest, opts ...grpc.CallOption) (*GetAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAlertsResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) WatchReport(ctx context.Context, in *WatchReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WeatherService_ServiceDesc.Streams[0], WeatherService_WatchReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchReportRequest, WatchReportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WeatherService_WatchReportClient = grpc.ServerStreamingClient[WatchReportResponse]
+
+func (c *weatherServiceClient) GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHistoryResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetDailySummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (*GetSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSummaryResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetDailySummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weatherServiceClient) GetHourlySummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (*GetSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSummaryResponse)
+	err := c.cc.Invoke(ctx, WeatherService_GetHourlySummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeatherServiceServer is the server API for WeatherService service.
 // All implementations must embed UnimplementedWeatherServiceServer
 // for forward compatibility.
 type WeatherServiceServer interface {
 	GetCurrentReport(context.Context, *GetCurrentReportRequest) (*GetCurrentReportResponse, error)
 	GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error)
+	GetAlerts(context.Context, *GetAlertsRequest) (*GetAlertsResponse, error)
+	// WatchReport streams a new report each time the closest station publishes a new observation.
+	WatchReport(*WatchReportRequest, grpc.ServerStreamingServer[WatchReportResponse]) error
+	// GetHistory returns the reports recorded by the closest station over a period of time.
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	// GetDailySummary summarizes the reports recorded by the closest station for each day in its local time zone.
+	GetDailySummary(context.Context, *GetSummaryRequest) (*GetSummaryResponse, error)
+	// GetHourlySummary summarizes the reports recorded by the closest station for each hour in its local time zone.
+	GetHourlySummary(context.Context, *GetSummaryRequest) (*GetSummaryResponse, error)
 	mustEmbedUnimplementedWeatherServiceServer()
 }
 
@@ -81,6 +163,21 @@ func (UnimplementedWeatherServiceServer) GetCurrentReport(context.Context, *GetC
 func (UnimplementedWeatherServiceServer) GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetForecast not implemented")
 }
+func (UnimplementedWeatherServiceServer) GetAlerts(context.Context, *GetAlertsRequest) (*GetAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlerts not implemented")
+}
+func (UnimplementedWeatherServiceServer) WatchReport(*WatchReportRequest, grpc.ServerStreamingServer[WatchReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchReport not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetDailySummary(context.Context, *GetSummaryRequest) (*GetSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailySummary not implemented")
+}
+func (UnimplementedWeatherServiceServer) GetHourlySummary(context.Context, *GetSummaryRequest) (*GetSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHourlySummary not implemented")
+}
 func (UnimplementedWeatherServiceServer) mustEmbedUnimplementedWeatherServiceServer() {}
 func (UnimplementedWeatherServiceServer) testEmbeddedByValue()                        {}
 
@@ -138,6 +235,89 @@ func _WeatherService_GetForecast_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WeatherService_GetAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetAlerts(ctx, req.(*GetAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_WatchReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeatherServiceServer).WatchReport(m, &grpc.GenericServerStream[WatchReportRequest, WatchReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WeatherService_WatchReportServer = grpc.ServerStreamingServer[WatchReportResponse]
+
+func _WeatherService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetDailySummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetDailySummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetDailySummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetDailySummary(ctx, req.(*GetSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WeatherService_GetHourlySummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeatherServiceServer).GetHourlySummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WeatherService_GetHourlySummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeatherServiceServer).GetHourlySummary(ctx, req.(*GetSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WeatherService_ServiceDesc is the grpc.ServiceDesc for WeatherService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +333,138 @@ var WeatherService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetForecast",
 			Handler:    _WeatherService_GetForecast_Handler,
 		},
+		{
+			MethodName: "GetAlerts",
+			Handler:    _WeatherService_GetAlerts_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _WeatherService_GetHistory_Handler,
+		},
+		{
+			MethodName: "GetDailySummary",
+			Handler:    _WeatherService_GetDailySummary_Handler,
+		},
+		{
+			MethodName: "GetHourlySummary",
+			Handler:    _WeatherService_GetHourlySummary_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchReport",
+			Handler:       _WeatherService_WatchReport_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "weather.proto",
+}
+
+const (
+	AdminService_SetOfflineMode_FullMethodName = "/faltung.nerves.weather.AdminService/SetOfflineMode"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService controls the operation of the server. It isn't served alongside WeatherService, but on a separate
+// listener which should only be reachable by operators.
+type AdminServiceClient interface {
+	// SetOfflineMode switches between offline mode, where no upstream requests are made, and online mode.
+	SetOfflineMode(ctx context.Context, in *SetOfflineModeRequest, opts ...grpc.CallOption) (*SetOfflineModeResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SetOfflineMode(ctx context.Context, in *SetOfflineModeRequest, opts ...grpc.CallOption) (*SetOfflineModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOfflineModeResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetOfflineMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService controls the operation of the server. It isn't served alongside WeatherService, but on a separate
+// listener which should only be reachable by operators.
+type AdminServiceServer interface {
+	// SetOfflineMode switches between offline mode, where no upstream requests are made, and online mode.
+	SetOfflineMode(context.Context, *SetOfflineModeRequest) (*SetOfflineModeResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) SetOfflineMode(context.Context, *SetOfflineModeRequest) (*SetOfflineModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOfflineMode not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_SetOfflineMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOfflineModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetOfflineMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetOfflineMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetOfflineMode(ctx, req.(*SetOfflineModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "faltung.nerves.weather.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetOfflineMode",
+			Handler:    _AdminService_SetOfflineMode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "weather.proto",